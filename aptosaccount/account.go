@@ -70,6 +70,49 @@ func (a *Account) Sign(data []byte, salt string) []byte {
 	return Sign(a.PrivateKey, data, salt)
 }
 
+// The methods of `transactionbuilder.Signer`
+
+// The signature scheme of ed25519 account
+const Ed25519Scheme = 0x0
+
+func (a *Account) Scheme() uint8 {
+	return Ed25519Scheme
+}
+
+func (a *Account) PublicKeyBytes() []byte {
+	return a.PublicKey
+}
+
+func (a *Account) AuthenticationKey() [32]byte {
+	return a.AuthKey
+}
+
+func (a *Account) AccountAddress() [32]byte {
+	return a.AuthKey
+}
+
+// SignMessage signs the message without salt, the message should be a signing message with the salt prefix
+func (a *Account) SignMessage(message []byte) ([]byte, error) {
+	return a.Sign(message, ""), nil
+}
+
+/**
+ * AccountWithAddress is the account whose authentication key has been rotated,
+ * so its address is different from the authentication key of its current key.
+ */
+type AccountWithAddress struct {
+	*Account
+	Address [32]byte
+}
+
+func NewAccountWithAddress(account *Account, address [32]byte) *AccountWithAddress {
+	return &AccountWithAddress{Account: account, Address: address}
+}
+
+func (a *AccountWithAddress) AccountAddress() [32]byte {
+	return a.Address
+}
+
 func Sign(privateKey ed25519.PrivateKey, data []byte, salt string) []byte {
 	prefixBytes := []byte{}
 	if len(salt) > 0 {
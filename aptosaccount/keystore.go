@@ -0,0 +1,516 @@
+package aptosaccount
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	KeystoreVersion = 1
+	KeystoreCipher  = "aes-256-gcm"
+
+	KdfScrypt   = "scrypt"
+	KdfArgon2id = "argon2id"
+
+	// The derived key contains the encryption key and the mac key
+	keystoreDerivedKeyLength = 64
+	keystoreSaltLength       = 32
+)
+
+var (
+	ErrKeystoreDecrypt   = errors.New("Could not decrypt key with given password.")
+	ErrKeystoreNotFound  = errors.New("No key for given address.")
+	ErrKeystoreDuplicate = errors.New("The key of address already exists.")
+	ErrKeystoreKdfLimit  = errors.New("The kdf parameters exceed the limit of standard options.")
+)
+
+/**
+ * KdfOptions is the key derivation function and its cost parameters used to encrypt the key.
+ * `StandardScrypt` is used if the options are not specified.
+ */
+type KdfOptions struct {
+	Kdf string
+	// scrypt parameters
+	N int
+	R int
+	P int
+	// argon2id parameters
+	Time    uint32
+	Memory  uint32 // KiB
+	Threads uint8
+}
+
+var (
+	// Takes about 1 second and 256MB memory on a modern processor
+	StandardScrypt = KdfOptions{Kdf: KdfScrypt, N: 1 << 18, R: 8, P: 1}
+	// Takes about 100 milliseconds and 4MB memory on a modern processor
+	LightScrypt = KdfOptions{Kdf: KdfScrypt, N: 1 << 12, R: 8, P: 6}
+	// The recommended parameters of RFC 9106
+	StandardArgon2id = KdfOptions{Kdf: KdfArgon2id, Time: 1, Memory: 2 * 1024 * 1024, Threads: 4}
+	LightArgon2id    = KdfOptions{Kdf: KdfArgon2id, Time: 3, Memory: 64 * 1024, Threads: 4}
+)
+
+// Keystore is the JSON format of encrypted key, the address is stored in clear text
+type Keystore struct {
+	Address string         `json:"address"`
+	Crypto  KeystoreCrypto `json:"crypto"`
+	Id      string         `json:"id"`
+	Version int            `json:"version"`
+}
+
+type KeystoreCrypto struct {
+	Cipher       string               `json:"cipher"`
+	CipherText   string               `json:"ciphertext"`
+	CipherParams KeystoreCipherParams `json:"cipherparams"`
+	Kdf          string               `json:"kdf"`
+	KdfParams    KeystoreKdfParams    `json:"kdfparams"`
+	Mac          string               `json:"mac"`
+}
+
+type KeystoreCipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+type KeystoreKdfParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	// scrypt parameters
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+	// argon2id parameters
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// EncryptKey encrypts the private key of account with the password, and returns the JSON of keystore
+func EncryptKey(account *Account, password string, options KdfOptions) ([]byte, error) {
+	keystore, err := NewKeystore(account, password, options)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(keystore)
+}
+
+// DecryptKey decrypts the JSON of keystore with the password
+func DecryptKey(keyJSON []byte, password string) (*Account, error) {
+	keystore := &Keystore{}
+	if err := json.Unmarshal(keyJSON, keystore); err != nil {
+		return nil, err
+	}
+	return keystore.Decrypt(password)
+}
+
+func NewKeystore(account *Account, password string, options KdfOptions) (*Keystore, error) {
+	if options.Kdf == "" {
+		options = StandardScrypt
+	}
+	salt, err := randomBytes(keystoreSaltLength)
+	if err != nil {
+		return nil, err
+	}
+	kdfParams := KeystoreKdfParams{
+		DKLen: keystoreDerivedKeyLength,
+		Salt:  hex.EncodeToString(salt),
+	}
+	switch options.Kdf {
+	case KdfScrypt:
+		kdfParams.N, kdfParams.R, kdfParams.P = options.N, options.R, options.P
+	case KdfArgon2id:
+		kdfParams.Time, kdfParams.Memory, kdfParams.Threads = options.Time, options.Memory, options.Threads
+	default:
+		return nil, fmt.Errorf("Unsupported kdf %v.", options.Kdf)
+	}
+	derivedKey, err := deriveKey(options.Kdf, kdfParams, password)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newKeystoreAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	cipherText := aead.Seal(nil, nonce, account.PrivateKey.Seed(), nil)
+
+	id, err := randomBytes(16)
+	if err != nil {
+		return nil, err
+	}
+	return &Keystore{
+		Address: addressHex(account.AccountAddress()),
+		Crypto: KeystoreCrypto{
+			Cipher:       KeystoreCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: KeystoreCipherParams{Nonce: hex.EncodeToString(nonce)},
+			Kdf:          options.Kdf,
+			KdfParams:    kdfParams,
+			Mac:          hex.EncodeToString(keystoreMac(derivedKey, cipherText)),
+		},
+		Id:      uuidString(id),
+		Version: KeystoreVersion,
+	}, nil
+}
+
+// Decrypt returns the account of keystore, the mac is checked in constant time before decryption
+func (k *Keystore) Decrypt(password string) (*Account, error) {
+	if k.Version != KeystoreVersion {
+		return nil, fmt.Errorf("Unsupported keystore version %v.", k.Version)
+	}
+	if k.Crypto.Cipher != KeystoreCipher {
+		return nil, fmt.Errorf("Unsupported cipher %v.", k.Crypto.Cipher)
+	}
+	if k.Crypto.KdfParams.DKLen != keystoreDerivedKeyLength {
+		return nil, fmt.Errorf("Invalid derived key length %v.", k.Crypto.KdfParams.DKLen)
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(k.Crypto.CipherParams.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(k.Crypto.Mac)
+	if err != nil {
+		return nil, err
+	}
+	derivedKey, err := deriveKey(k.Crypto.Kdf, k.Crypto.KdfParams, password)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(keystoreMac(derivedKey, cipherText), mac) != 1 {
+		return nil, ErrKeystoreDecrypt
+	}
+
+	aead, err := newKeystoreAEAD(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("Invalid cipher nonce.")
+	}
+	seed, err := aead.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return nil, ErrKeystoreDecrypt
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.New("Invalid private key length.")
+	}
+	address, err := normalizeAddress(k.Address)
+	if err != nil {
+		return nil, err
+	}
+	account := NewAccount(seed)
+	if addressHex(account.AccountAddress()) != address {
+		return nil, errors.New("The decrypted key does not match the address.")
+	}
+	return account, nil
+}
+
+func deriveKey(kdf string, params KeystoreKdfParams, password string) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	switch kdf {
+	case KdfScrypt:
+		if params.N <= 0 || params.R <= 0 || params.P <= 0 {
+			return nil, errors.New("Invalid scrypt parameters.")
+		}
+		if err := checkScryptLimit(params); err != nil {
+			return nil, err
+		}
+		return scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DKLen)
+	case KdfArgon2id:
+		if params.Time == 0 || params.Memory == 0 || params.Threads == 0 {
+			return nil, errors.New("Invalid argon2id parameters.")
+		}
+		if err := checkArgon2idLimit(params); err != nil {
+			return nil, err
+		}
+		return argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(params.DKLen)), nil
+	}
+	return nil, fmt.Errorf("Unsupported kdf %v.", kdf)
+}
+
+// checkScryptLimit rejects the parameters of keystore file that cost more memory (N * r) or time (N * r * p)
+// than `StandardScrypt`, so an untrusted file can't exhaust the resources.
+func checkScryptLimit(params KeystoreKdfParams) error {
+	maxCost := StandardScrypt.N * StandardScrypt.R * StandardScrypt.P
+	if params.N > StandardScrypt.N || params.R > StandardScrypt.R || params.P > maxCost/(params.N*params.R) {
+		return ErrKeystoreKdfLimit
+	}
+	return nil
+}
+
+// checkArgon2idLimit rejects the parameters that cost more memory or time (time * memory) than `StandardArgon2id`
+func checkArgon2idLimit(params KeystoreKdfParams) error {
+	maxCost := uint64(StandardArgon2id.Time) * uint64(StandardArgon2id.Memory)
+	if params.Memory > StandardArgon2id.Memory || uint64(params.Time)*uint64(params.Memory) > maxCost {
+		return ErrKeystoreKdfLimit
+	}
+	return nil
+}
+
+func newKeystoreAEAD(derivedKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(derivedKey[:32])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// The mac is sha3-256(derivedKey[32:64] || ciphertext)
+func keystoreMac(derivedKey, cipherText []byte) []byte {
+	hash := sha3.New256()
+	hash.Write(derivedKey[32:])
+	hash.Write(cipherText)
+	return hash.Sum(nil)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+// uuidString formats the random bytes as an UUID of version 4
+func uuidString(b []byte) string {
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func addressHex(address [32]byte) string {
+	return "0x" + hex.EncodeToString(address[:])
+}
+
+// normalizeAddress returns the full length address with 0x prefix in lower case, e.g. 0x1 → 0x000...001,
+// error if the address is not hex of at most 64 chars, so it's safe to be used as the file name.
+func normalizeAddress(address string) (string, error) {
+	hexAddress := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	if len(hexAddress) == 0 || len(hexAddress) > 64 {
+		return "", fmt.Errorf("Invalid address %v.", address)
+	}
+	hexAddress = strings.Repeat("0", 64-len(hexAddress)) + hexAddress
+	if _, err := hex.DecodeString(hexAddress); err != nil {
+		return "", fmt.Errorf("Invalid address %v.", address)
+	}
+	return "0x" + hexAddress, nil
+}
+
+// ------ KeyStore ------
+
+// KeyStore manages the encrypted keys in a directory, each key is stored in the file named by its address
+type KeyStore struct {
+	Dir string
+	// The kdf of new keys, default is `StandardScrypt`
+	KdfOptions KdfOptions
+}
+
+func NewKeyStore(dir string, options KdfOptions) *KeyStore {
+	return &KeyStore{Dir: dir, KdfOptions: options}
+}
+
+// NewAccount creates a random account and stores it with the password
+func (ks *KeyStore) NewAccount(password string) (*Account, error) {
+	seed, err := randomBytes(ed25519.SeedSize)
+	if err != nil {
+		return nil, err
+	}
+	account := NewAccount(seed)
+	if err := ks.Import(account, password); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// Import stores the account with the password, error if the address already exists
+func (ks *KeyStore) Import(account *Account, password string) error {
+	keystore, err := NewKeystore(account, password, ks.KdfOptions)
+	if err != nil {
+		return err
+	}
+	return ks.writeKeystore(keystore, false)
+}
+
+// ImportKeyJSON decrypts the JSON of keystore and stores it with the new password
+func (ks *KeyStore) ImportKeyJSON(keyJSON []byte, password, newPassword string) (*Account, error) {
+	account, err := DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, err
+	}
+	if err := ks.Import(account, newPassword); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// Export returns the JSON of key encrypted with the new password
+func (ks *KeyStore) Export(address, password, newPassword string) ([]byte, error) {
+	account, err := ks.Unlock(address, password)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptKey(account, newPassword, ks.KdfOptions)
+}
+
+// Accounts returns the addresses of all keys in the directory
+func (ks *KeyStore) Accounts() ([]string, error) {
+	entries, err := os.ReadDir(ks.Dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	addresses := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		keystore, err := readKeystore(filepath.Join(ks.Dir, entry.Name()))
+		if err != nil {
+			// skip the files that are not keystore
+			continue
+		}
+		address, err := normalizeAddress(keystore.Address)
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// HasAddress returns true if the key of address is in the directory, false if the address is invalid
+func (ks *KeyStore) HasAddress(address string) bool {
+	path, err := ks.keyFile(address)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// Unlock decrypts the key of address with the password
+func (ks *KeyStore) Unlock(address, password string) (*Account, error) {
+	keystore, err := ks.readKeystore(address)
+	if err != nil {
+		return nil, err
+	}
+	return keystore.Decrypt(password)
+}
+
+// Update changes the password of the key of address
+func (ks *KeyStore) Update(address, password, newPassword string) error {
+	account, err := ks.Unlock(address, password)
+	if err != nil {
+		return err
+	}
+	keystore, err := NewKeystore(account, newPassword, ks.KdfOptions)
+	if err != nil {
+		return err
+	}
+	return ks.writeKeystore(keystore, true)
+}
+
+// Delete removes the key of address, the password is required to prevent deleting by mistake
+func (ks *KeyStore) Delete(address, password string) error {
+	if _, err := ks.Unlock(address, password); err != nil {
+		return err
+	}
+	path, err := ks.keyFile(address)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+func (ks *KeyStore) keyFile(address string) (string, error) {
+	address, err := normalizeAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(ks.Dir, address+".json"), nil
+}
+
+func (ks *KeyStore) readKeystore(address string) (*Keystore, error) {
+	path, err := ks.keyFile(address)
+	if err != nil {
+		return nil, err
+	}
+	keystore, err := readKeystore(path)
+	if os.IsNotExist(err) {
+		return nil, ErrKeystoreNotFound
+	}
+	return keystore, err
+}
+
+func (ks *KeyStore) writeKeystore(keystore *Keystore, overwrite bool) error {
+	path, err := ks.keyFile(keystore.Address)
+	if err != nil {
+		return err
+	}
+	if !overwrite && ks.HasAddress(keystore.Address) {
+		return ErrKeystoreDuplicate
+	}
+	data, err := json.Marshal(keystore)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(ks.Dir, 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first, so the key won't be broken if the writing fails
+	tmpFile, err := os.CreateTemp(ks.Dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func readKeystore(path string) (*Keystore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keystore := &Keystore{}
+	if err := json.Unmarshal(data, keystore); err != nil {
+		return nil, err
+	}
+	if keystore.Address == "" || keystore.Version != KeystoreVersion {
+		return nil, errors.New("Invalid keystore file.")
+	}
+	return keystore, nil
+}
@@ -0,0 +1,182 @@
+package aptosaccount
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testArgon2id = KdfOptions{Kdf: KdfArgon2id, Time: 1, Memory: 1024, Threads: 1}
+
+func TestEncryptKey_DecryptKey(t *testing.T) {
+	account := NewAccount(seed[:])
+	for _, options := range []KdfOptions{LightScrypt, testArgon2id} {
+		t.Run(options.Kdf, func(t *testing.T) {
+			keyJSON, err := EncryptKey(account, "password", options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			keystore := &Keystore{}
+			if err := json.Unmarshal(keyJSON, keystore); err != nil {
+				t.Fatal(err)
+			}
+			if keystore.Address != addressHex(account.AuthKey) || keystore.Crypto.Kdf != options.Kdf {
+				t.Fatalf("invalid keystore %s", keyJSON)
+			}
+
+			got, err := DecryptKey(keyJSON, "password")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, account) {
+				t.Errorf("DecryptKey() got = %v, want %v", got, account)
+			}
+			if _, err := DecryptKey(keyJSON, "wrong password"); err != ErrKeystoreDecrypt {
+				t.Errorf("DecryptKey() error = %v, want %v", err, ErrKeystoreDecrypt)
+			}
+
+			keystore.Crypto.CipherText = "00" + keystore.Crypto.CipherText[2:]
+			if _, err := keystore.Decrypt("password"); err != ErrKeystoreDecrypt {
+				t.Errorf("Decrypt() tampered error = %v, want %v", err, ErrKeystoreDecrypt)
+			}
+		})
+	}
+}
+
+func TestKeyStore(t *testing.T) {
+	ks := NewKeyStore(t.TempDir(), LightScrypt)
+	addresses, err := ks.Accounts()
+	if err != nil || len(addresses) != 0 {
+		t.Fatalf("Accounts() = %v, %v", addresses, err)
+	}
+
+	account := NewAccount(seed[:])
+	address := addressHex(account.AuthKey)
+	if err := ks.Import(account, "password"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Import(account, "password"); err != ErrKeystoreDuplicate {
+		t.Errorf("Import() duplicate error = %v", err)
+	}
+	created, err := ks.NewAccount("password2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addresses, err = ks.Accounts()
+	if err != nil || len(addresses) != 2 {
+		t.Fatalf("Accounts() = %v, %v", addresses, err)
+	}
+
+	got, err := ks.Unlock(address, "password")
+	if err != nil || !reflect.DeepEqual(got, account) {
+		t.Fatalf("Unlock() = %v, %v", got, err)
+	}
+	if _, err := ks.Unlock(address, "password2"); err != ErrKeystoreDecrypt {
+		t.Errorf("Unlock() wrong password error = %v", err)
+	}
+	if _, err := ks.Unlock("0x1", "password"); err != ErrKeystoreNotFound {
+		t.Errorf("Unlock() not found error = %v", err)
+	}
+
+	if err := ks.Update(address, "password", "new password"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.Unlock(address, "password"); err != ErrKeystoreDecrypt {
+		t.Errorf("Unlock() old password error = %v", err)
+	}
+
+	keyJSON, err := ks.Export(address, "new password", "export password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := NewKeyStore(t.TempDir(), testArgon2id)
+	imported, err := other.ImportKeyJSON(keyJSON, "export password", "password")
+	if err != nil || !reflect.DeepEqual(imported, account) {
+		t.Fatalf("ImportKeyJSON() = %v, %v", imported, err)
+	}
+
+	if err := ks.Delete(addressHex(created.AuthKey), "password"); err != ErrKeystoreDecrypt {
+		t.Errorf("Delete() wrong password error = %v", err)
+	}
+	if err := ks.Delete(addressHex(created.AuthKey), "password2"); err != nil {
+		t.Fatal(err)
+	}
+	addresses, err = ks.Accounts()
+	if err != nil || !reflect.DeepEqual(addresses, []string{address}) {
+		t.Fatalf("Accounts() = %v, %v", addresses, err)
+	}
+}
+
+func TestKeyStore_InvalidAddress(t *testing.T) {
+	dir := t.TempDir()
+	ks := NewKeyStore(filepath.Join(dir, "keys"), LightScrypt)
+	// the file outside the directory of keystore must not be reachable
+	if err := os.WriteFile(filepath.Join(dir, "x.json"), []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for _, address := range []string{"../x", "0x../../x", "", "0x", "0xzz", "0x" + strings.Repeat("1", 65)} {
+		if ks.HasAddress(address) {
+			t.Errorf("HasAddress(%q) = true", address)
+		}
+		if _, err := ks.Unlock(address, "password"); err == nil || err == ErrKeystoreNotFound {
+			t.Errorf("Unlock(%q) error = %v", address, err)
+		}
+		if err := ks.Update(address, "password", "new password"); err == nil || err == ErrKeystoreNotFound {
+			t.Errorf("Update(%q) error = %v", address, err)
+		}
+		if err := ks.Delete(address, "password"); err == nil || err == ErrKeystoreNotFound {
+			t.Errorf("Delete(%q) error = %v", address, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "x.json")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecryptKey_KdfLimit(t *testing.T) {
+	params := func(options KdfOptions) KeystoreKdfParams {
+		return KeystoreKdfParams{N: options.N, R: options.R, P: options.P, Time: options.Time, Memory: options.Memory, Threads: options.Threads}
+	}
+	for _, options := range []KdfOptions{StandardScrypt, LightScrypt} {
+		if err := checkScryptLimit(params(options)); err != nil {
+			t.Errorf("checkScryptLimit(%+v) error = %v", options, err)
+		}
+	}
+	for _, options := range []KdfOptions{StandardArgon2id, LightArgon2id} {
+		if err := checkArgon2idLimit(params(options)); err != nil {
+			t.Errorf("checkArgon2idLimit(%+v) error = %v", options, err)
+		}
+	}
+
+	account := NewAccount(seed[:])
+	tests := []struct {
+		name    string
+		options KdfOptions
+		modify  func(p *KeystoreKdfParams)
+	}{
+		{"scrypt n", LightScrypt, func(p *KeystoreKdfParams) { p.N = 1 << 30 }},
+		{"scrypt r", LightScrypt, func(p *KeystoreKdfParams) { p.R = 1 << 20 }},
+		{"scrypt p", LightScrypt, func(p *KeystoreKdfParams) { p.P = 1 << 20 }},
+		{"scrypt negative", LightScrypt, func(p *KeystoreKdfParams) { p.R = -1 }},
+		{"argon2id memory", testArgon2id, func(p *KeystoreKdfParams) { p.Memory = 1 << 30 }},
+		{"argon2id time", testArgon2id, func(p *KeystoreKdfParams) { p.Time = 1 << 30 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keystore, err := NewKeystore(account, "password", tt.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.modify(&keystore.Crypto.KdfParams)
+			if _, err := keystore.Decrypt("password"); err == nil || err == ErrKeystoreDecrypt {
+				t.Errorf("Decrypt() error = %v", err)
+			}
+		})
+	}
+	if _, err := NewKeystore(account, "password", KdfOptions{Kdf: KdfScrypt, N: 1 << 20, R: 8, P: 1}); err != ErrKeystoreKdfLimit {
+		t.Errorf("NewKeystore() error = %v, want %v", err, ErrKeystoreKdfLimit)
+	}
+}
@@ -0,0 +1,116 @@
+package aptosaccount
+
+import (
+	"errors"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// The signature scheme of single key account, the public key is wrapped by `AnyPublicKey`
+	SingleKeyScheme = 0x2
+
+	// The variant index of secp256k1 in the `AnyPublicKey` and `AnySignature` enums
+	anySecp256k1Variant = 0x1
+
+	Secp256k1PrivateKeyLength = 32
+	Secp256k1PublicKeyLength  = 65
+	Secp256k1SignatureLength  = 64
+)
+
+// Secp256k1Account is a `SingleKey` account backed by secp256k1 ECDSA key, e.g. the key of Ethereum
+type Secp256k1Account struct {
+	PrivateKey *secp256k1.PrivateKey
+	// The uncompressed public key with 0x04 prefix
+	PublicKey []byte
+	AuthKey   [32]byte
+}
+
+// NewSecp256k1Account imports the 32 bytes private key, e.g. the private key of Ethereum
+func NewSecp256k1Account(privateKey []byte) (*Secp256k1Account, error) {
+	if len(privateKey) != Secp256k1PrivateKeyLength {
+		return nil, errors.New("Secp256k1 private key length should be 32")
+	}
+	var scalar secp256k1.ModNScalar
+	if overflow := scalar.SetByteSlice(privateKey); overflow || scalar.IsZero() {
+		return nil, errors.New("Invalid secp256k1 private key.")
+	}
+	return newSecp256k1Account(secp256k1.NewPrivateKey(&scalar)), nil
+}
+
+// GenerateSecp256k1Account generates a random secp256k1 account
+func GenerateSecp256k1Account() (*Secp256k1Account, error) {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256k1Account(privateKey), nil
+}
+
+func newSecp256k1Account(privateKey *secp256k1.PrivateKey) *Secp256k1Account {
+	publicKey := privateKey.PubKey().SerializeUncompressed()
+	return &Secp256k1Account{
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		AuthKey:    sha3.Sum256(append(anySecp256k1PublicKeyBytes(publicKey), SingleKeyScheme)),
+	}
+}
+
+// Sign signs the sha3-256 hash of message, the signature is 64 bytes `r || s` with low s
+func (a *Secp256k1Account) Sign(message []byte) []byte {
+	hash := sha3.Sum256(message)
+	// the compact signature is `recovery code || r || s`, and s is always normalized to the lower half
+	return ecdsa.SignCompact(a.PrivateKey, hash[:], false)[1:]
+}
+
+// The methods of `transactionbuilder.Signer`
+
+func (a *Secp256k1Account) Scheme() uint8 {
+	return SingleKeyScheme
+}
+
+// PublicKeyBytes returns the BCS bytes of `AnyPublicKey`
+func (a *Secp256k1Account) PublicKeyBytes() []byte {
+	return anySecp256k1PublicKeyBytes(a.PublicKey)
+}
+
+func (a *Secp256k1Account) AuthenticationKey() [32]byte {
+	return a.AuthKey
+}
+
+func (a *Secp256k1Account) AccountAddress() [32]byte {
+	return a.AuthKey
+}
+
+// SignMessage returns the BCS bytes of `AnySignature`
+func (a *Secp256k1Account) SignMessage(message []byte) ([]byte, error) {
+	signature := a.Sign(message)
+	return append([]byte{anySecp256k1Variant, Secp256k1SignatureLength}, signature...), nil
+}
+
+// VerifySecp256k1 verifies the signature of `Secp256k1Account.Sign`, the signature with high s is rejected
+func VerifySecp256k1(publicKey, message, signature []byte) bool {
+	if len(signature) != Secp256k1SignatureLength {
+		return false
+	}
+	key, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return false
+	}
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(signature[:32]); overflow || r.IsZero() {
+		return false
+	}
+	if overflow := s.SetByteSlice(signature[32:]); overflow || s.IsZero() || s.IsOverHalfOrder() {
+		return false
+	}
+	hash := sha3.Sum256(message)
+	return ecdsa.NewSignature(&r, &s).Verify(hash[:], key)
+}
+
+// anySecp256k1PublicKeyBytes returns the BCS bytes of `AnyPublicKey::Secp256k1Ecdsa`
+func anySecp256k1PublicKeyBytes(publicKey []byte) []byte {
+	return append([]byte{anySecp256k1Variant, Secp256k1PublicKeyLength}, publicKey...)
+}
@@ -0,0 +1,58 @@
+package aptosaccount
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestSecp256k1Account_Sign_Verify(t *testing.T) {
+	account, err := NewSecp256k1Account(seed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(account.PublicKey) != Secp256k1PublicKeyLength || account.PublicKey[0] != 0x04 {
+		t.Fatalf("invalid public key %x", account.PublicKey)
+	}
+	authKey := sha3.Sum256(append(append([]byte{0x01, 0x41}, account.PublicKey...), 0x02))
+	if account.AuthKey != authKey {
+		t.Errorf("AuthKey = %x, want %x", account.AuthKey, authKey)
+	}
+
+	message := []byte{0x1}
+	signature := account.Sign(message)
+	if !bytes.Equal(signature, account.Sign(message)) {
+		t.Error("Sign() should be deterministic")
+	}
+	if !VerifySecp256k1(account.PublicKey, message, signature) {
+		t.Error("VerifySecp256k1() = false")
+	}
+	if VerifySecp256k1(account.PublicKey, []byte{0x2}, signature) {
+		t.Error("VerifySecp256k1() of other message = true")
+	}
+
+	anySignature, err := account.SignMessage(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(anySignature, append([]byte{0x01, 0x40}, signature...)) {
+		t.Errorf("SignMessage() = %x", anySignature)
+	}
+}
+
+func TestNewSecp256k1Account_Invalid(t *testing.T) {
+	for _, privateKey := range [][]byte{make([]byte, 31), make([]byte, 32), bytes.Repeat([]byte{0xff}, 32)} {
+		if _, err := NewSecp256k1Account(privateKey); err == nil {
+			t.Errorf("NewSecp256k1Account(%x) should fail", privateKey)
+		}
+	}
+	account, err := GenerateSecp256k1Account()
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := NewSecp256k1Account(account.PrivateKey.Serialize())
+	if err != nil || imported.AuthKey != account.AuthKey {
+		t.Errorf("NewSecp256k1Account() = %v, %v", imported, err)
+	}
+}
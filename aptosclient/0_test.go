@@ -2,6 +2,8 @@ package aptosclient
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os/exec"
 	"strings"
 	"testing"
@@ -21,6 +23,24 @@ func Client(t *testing.T, url string) RestClient {
 	return *cli
 }
 
+const mockLedgerInfoJson = `{"chain_id":4,"epoch":"1","ledger_version":"100","oldest_ledger_version":"0","ledger_timestamp":"1665000000000000","node_role":"full_node","oldest_block_height":"0","block_height":"10"}`
+
+// MockClient starts a local server that answers the ledger info request itself and
+// passes every other request of the versioned api to handler.
+func MockClient(t *testing.T, handler http.HandlerFunc) *RestClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1" {
+			w.Write([]byte(mockLedgerInfoJson))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	cli, err := Dial(context.Background(), server.URL)
+	require.Nil(t, err)
+	return cli
+}
+
 func txnSubmitableForTest(t *testing.T) bool {
 	out, _ := exec.Command("whoami").Output()
 	user := strings.TrimSpace(string(out))
@@ -1,6 +1,7 @@
 package aptosclient
 
 import (
+	"context"
 	"math/big"
 	"net/http"
 	"strconv"
@@ -9,7 +10,11 @@ import (
 )
 
 func (c *RestClient) GetAccount(address string) (res *aptostypes.AccountCoreData, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address, nil)
+	return c.GetAccountWithContext(context.Background(), address)
+}
+
+func (c *RestClient) GetAccountWithContext(ctx context.Context, address string) (res *aptostypes.AccountCoreData, err error) {
+	req, err := c.newAccountRequest(ctx, address)
 	if err != nil {
 		return
 	}
@@ -18,42 +23,74 @@ func (c *RestClient) GetAccount(address string) (res *aptostypes.AccountCoreData
 	return
 }
 
+func (c *RestClient) newAccountRequest(ctx context.Context, address string) (*http.Request, error) {
+	return http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address, nil)
+}
+
 func (c *RestClient) GetAccountResources(address string, version uint64) (res []aptostypes.AccountResource, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/resources", nil)
+	return c.GetAccountResourcesWithContext(context.Background(), address, version)
+}
+
+func (c *RestClient) GetAccountResourcesWithContext(ctx context.Context, address string, version uint64) (res []aptostypes.AccountResource, err error) {
+	req, err := c.newAccountResourcesRequest(ctx, address, version)
 	if err != nil {
 		return
 	}
+	err = c.doReq(req, &res)
+	return
+}
+
+func (c *RestClient) newAccountResourcesRequest(ctx context.Context, address string, version uint64) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/resources", nil)
+	if err != nil {
+		return nil, err
+	}
 	if version > 0 {
 		q := req.URL.Query()
 		q.Add("ledger_version", strconv.FormatUint(version, 10))
 		req.URL.RawQuery = q.Encode()
 	}
-	err = c.doReq(req, &res)
-	return
+	return req, nil
 }
 
 func (c *RestClient) GetAccountResource(address string, resourceType string, version uint64) (res *aptostypes.AccountResource, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/resource/"+resourceType, nil)
+	return c.GetAccountResourceWithContext(context.Background(), address, resourceType, version)
+}
+
+func (c *RestClient) GetAccountResourceWithContext(ctx context.Context, address string, resourceType string, version uint64) (res *aptostypes.AccountResource, err error) {
+	req, err := c.newAccountResourceRequest(ctx, address, resourceType, version)
 	if err != nil {
 		return
 	}
 	res = &aptostypes.AccountResource{}
+	err = c.doReq(req, &res)
+	return
+}
+
+func (c *RestClient) newAccountResourceRequest(ctx context.Context, address string, resourceType string, version uint64) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/resource/"+resourceType, nil)
+	if err != nil {
+		return nil, err
+	}
 	if version > 0 {
 		q := req.URL.Query()
 		q.Add("ledger_version", strconv.FormatUint(version, 10))
 		req.URL.RawQuery = q.Encode()
 	}
-	err = c.doReq(req, &res)
-	return
+	return req, nil
 }
 
 // Variation of `GetAccountResource`: when specified resource is not found (error with code 404), this will return `nil` result and `nil` error
 func (c *RestClient) GetAccountResourceHandle404(address, resourceType string, version uint64) (res *aptostypes.AccountResource, err error) {
-	res, err = c.GetAccountResource(address, resourceType, version)
+	return c.GetAccountResourceHandle404WithContext(context.Background(), address, resourceType, version)
+}
+
+func (c *RestClient) GetAccountResourceHandle404WithContext(ctx context.Context, address, resourceType string, version uint64) (res *aptostypes.AccountResource, err error) {
+	res, err = c.GetAccountResourceWithContext(ctx, address, resourceType, version)
 	if err == nil {
 		return res, nil
 	}
-	if e := err.(*aptostypes.RestError); e != nil && e.Code == 404 {
+	if e, ok := err.(*aptostypes.RestError); ok && e.Code == 404 {
 		return nil, nil
 	} else {
 		return nil, err
@@ -61,7 +98,11 @@ func (c *RestClient) GetAccountResourceHandle404(address, resourceType string, v
 }
 
 func (c *RestClient) IsAccountHasResource(address string, resourceType string, version uint64) (bool, error) {
-	res, err := c.GetAccountResourceHandle404(address, resourceType, version)
+	return c.IsAccountHasResourceWithContext(context.Background(), address, resourceType, version)
+}
+
+func (c *RestClient) IsAccountHasResourceWithContext(ctx context.Context, address string, resourceType string, version uint64) (bool, error) {
+	res, err := c.GetAccountResourceHandle404WithContext(ctx, address, resourceType, version)
 	if err != nil {
 		return false, err
 	} else {
@@ -70,7 +111,11 @@ func (c *RestClient) IsAccountHasResource(address string, resourceType string, v
 }
 
 func (c *RestClient) GetAccountModules(address string, version uint64) (res []aptostypes.MoveModule, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/modules", nil)
+	return c.GetAccountModulesWithContext(context.Background(), address, version)
+}
+
+func (c *RestClient) GetAccountModulesWithContext(ctx context.Context, address string, version uint64) (res []aptostypes.MoveModule, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/modules", nil)
 	if err != nil {
 		return
 	}
@@ -84,7 +129,11 @@ func (c *RestClient) GetAccountModules(address string, version uint64) (res []ap
 }
 
 func (c *RestClient) GetAccountModule(address, moduleName string, version uint64) (res *aptostypes.MoveModule, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/module/"+moduleName, nil)
+	return c.GetAccountModuleWithContext(context.Background(), address, moduleName, version)
+}
+
+func (c *RestClient) GetAccountModuleWithContext(ctx context.Context, address, moduleName string, version uint64) (res *aptostypes.MoveModule, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/module/"+moduleName, nil)
 	if err != nil {
 		return
 	}
@@ -99,23 +148,31 @@ func (c *RestClient) GetAccountModule(address, moduleName string, version uint64
 }
 
 func (c *RestClient) AptosBalanceOf(address string) (balance *big.Int, err error) {
-	return c.BalanceOf(address, "0x1::aptos_coin::AptosCoin")
+	return c.AptosBalanceOfWithContext(context.Background(), address)
+}
+
+func (c *RestClient) AptosBalanceOfWithContext(ctx context.Context, address string) (balance *big.Int, err error) {
+	return c.BalanceOfWithContext(ctx, address, "0x1::aptos_coin::AptosCoin")
 }
 
 func (c *RestClient) BalanceOf(address string, coinTag string) (balance *big.Int, err error) {
+	return c.BalanceOfWithContext(context.Background(), address, coinTag)
+}
+
+func (c *RestClient) BalanceOfWithContext(ctx context.Context, address string, coinTag string) (balance *big.Int, err error) {
 	t := "0x1::coin::CoinStore<" + coinTag + ">"
-	res, err := c.GetAccountResourceHandle404(address, t, 0)
+	store, err := GetAccountResourceAsWithContext[coinStoreResource](ctx, c, address, t, 0)
 	if err != nil {
+		if e, ok := err.(*aptostypes.RestError); ok && e.Code == 404 {
+			return big.NewInt(0), nil
+		}
 		return nil, err
 	}
-	if res == nil {
-		return big.NewInt(0), nil
-	}
-	coin := res.Data["coin"].(map[string]interface{})
-	value := coin["value"].(string)
-	balance, ok := big.NewInt(0).SetString(value, 10)
-	if !ok {
-		return big.NewInt(0), nil
-	}
-	return
+	return big.NewInt(0).SetUint64(uint64(store.Coin.Value)), nil
+}
+
+type coinStoreResource struct {
+	Coin struct {
+		Value aptostypes.U64 `json:"value"`
+	} `json:"coin"`
 }
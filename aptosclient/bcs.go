@@ -0,0 +1,143 @@
+package aptosclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+	"github.com/coming-chat/lcs"
+)
+
+const BCSContentType = "application/x-bcs"
+
+// The variations of reads with BCS response: the response body is decoded into `out` with the lcs codec,
+// so `out` should be a pointer of Go struct that matches the BCS layout of the Move type.
+// If `out` is `*[]byte`, the raw BCS bytes are returned without decoding.
+
+func (c *RestClient) GetAccountBCS(address string) (res *aptostypes.AccountResourceBCS, err error) {
+	return c.GetAccountBCSWithContext(context.Background(), address)
+}
+
+func (c *RestClient) GetAccountBCSWithContext(ctx context.Context, address string) (res *aptostypes.AccountResourceBCS, err error) {
+	req, err := c.newAccountRequest(ctx, address)
+	if err != nil {
+		return
+	}
+	res = &aptostypes.AccountResourceBCS{}
+	err = c.doReqBCS(req, res)
+	return
+}
+
+// GetAccountResourcesBCS returns the BCS of a map from the struct tag of resource to the BCS bytes of resource.
+func (c *RestClient) GetAccountResourcesBCS(out interface{}, address string, version uint64) (err error) {
+	return c.GetAccountResourcesBCSWithContext(context.Background(), out, address, version)
+}
+
+func (c *RestClient) GetAccountResourcesBCSWithContext(ctx context.Context, out interface{}, address string, version uint64) (err error) {
+	req, err := c.newAccountResourcesRequest(ctx, address, version)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+func (c *RestClient) GetAccountResourceBCS(out interface{}, address string, resourceType string, version uint64) (err error) {
+	return c.GetAccountResourceBCSWithContext(context.Background(), out, address, resourceType, version)
+}
+
+func (c *RestClient) GetAccountResourceBCSWithContext(ctx context.Context, out interface{}, address string, resourceType string, version uint64) (err error) {
+	req, err := c.newAccountResourceRequest(ctx, address, resourceType, version)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+// GetTransactionsBCS decodes the committed transactions, `out` should be `*[]transactionbuilder.TransactionOnChainData`
+func (c *RestClient) GetTransactionsBCS(out interface{}, start, limit uint64) (err error) {
+	return c.GetTransactionsBCSWithContext(context.Background(), out, start, limit)
+}
+
+func (c *RestClient) GetTransactionsBCSWithContext(ctx context.Context, out interface{}, start, limit uint64) (err error) {
+	req, err := c.newTransactionsRequest(ctx, start, limit)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+// GetAccountTransactionsBCS decodes the committed transactions, `out` should be `*[]transactionbuilder.TransactionOnChainData`
+func (c *RestClient) GetAccountTransactionsBCS(out interface{}, account string, start, limit uint64) (err error) {
+	return c.GetAccountTransactionsBCSWithContext(context.Background(), out, account, start, limit)
+}
+
+func (c *RestClient) GetAccountTransactionsBCSWithContext(ctx context.Context, out interface{}, account string, start, limit uint64) (err error) {
+	req, err := c.newAccountTransactionsRequest(ctx, account, start, limit)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+// GetTransactionByHashBCS decodes the transaction, `out` should be `*transactionbuilder.TransactionData`,
+// which is `TransactionDataPending` if the transaction has not been committed
+func (c *RestClient) GetTransactionByHashBCS(out interface{}, txHash string) (err error) {
+	return c.GetTransactionByHashBCSWithContext(context.Background(), out, txHash)
+}
+
+func (c *RestClient) GetTransactionByHashBCSWithContext(ctx context.Context, out interface{}, txHash string) (err error) {
+	req, err := c.newTransactionByHashRequest(ctx, txHash)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+// GetTransactionByVersionBCS decodes the transaction, `out` should be `*transactionbuilder.TransactionData`
+func (c *RestClient) GetTransactionByVersionBCS(out interface{}, txVersion string) (err error) {
+	return c.GetTransactionByVersionBCSWithContext(context.Background(), out, txVersion)
+}
+
+func (c *RestClient) GetTransactionByVersionBCSWithContext(ctx context.Context, out interface{}, txVersion string) (err error) {
+	req, err := c.newTransactionByVersionRequest(ctx, txVersion)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+func (c *RestClient) GetTableItemBCS(out interface{}, handle string, body TableItemRequest, ledgerVersion string) (err error) {
+	return c.GetTableItemBCSWithContext(context.Background(), out, handle, body, ledgerVersion)
+}
+
+func (c *RestClient) GetTableItemBCSWithContext(ctx context.Context, out interface{}, handle string, body TableItemRequest, ledgerVersion string) (err error) {
+	req, err := c.newTableItemRequest(ctx, handle, body, ledgerVersion)
+	if err != nil {
+		return
+	}
+	return c.doReqBCS(req, out)
+}
+
+// doReqBCS send request accepting BCS response and decode response body to out
+func (c *RestClient) doReqBCS(req *http.Request, out interface{}) error {
+	req.Header["Accept"] = []string{BCSContentType}
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		// the errors are always returned in json
+		return handleResponse(nil, resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if raw, ok := out.(*[]byte); ok {
+		*raw = body
+		return nil
+	}
+	return lcs.Unmarshal(body, out)
+}
@@ -0,0 +1,168 @@
+package aptosclient
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+	txBuilder "github.com/coming-chat/go-aptos/transaction_builder"
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountResourceWithBCS(t *testing.T) {
+	account := aptostypes.AccountResourceBCS{
+		AuthenticationKey:       make([]byte, 32),
+		SequenceNumber:          12,
+		KeyRotationEvents:       aptostypes.EventHandleBCS{Counter: 1, Guid: aptostypes.GuidBCS{CreationNumber: 1}},
+		RotationCapabilityOffer: aptostypes.CapabilityOfferBCS{For: [][32]byte{}},
+		SignerCapabilityOffer:   aptostypes.CapabilityOfferBCS{For: [][32]byte{{1}}},
+	}
+	coinStore := aptostypes.CoinStoreBCS{Coin: 100000000}
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, BCSContentType, r.Header.Get("Accept"))
+		var data []byte
+		var err error
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/resource/0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>"):
+			data, err = lcs.Marshal(coinStore)
+		case strings.HasSuffix(r.URL.Path, "/accounts/0x1"):
+			data, err = lcs.Marshal(account)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Resource not found","error_code":"resource_not_found"}`))
+			return
+		}
+		require.Nil(t, err)
+		w.Write(data)
+	})
+
+	accountRes, err := client.GetAccountBCS("0x1")
+	require.Nil(t, err)
+	require.Equal(t, account, *accountRes)
+
+	var coinStoreRes aptostypes.CoinStoreBCS
+	err = client.GetAccountResourceBCS(&coinStoreRes, "0x1", "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", 0)
+	require.Nil(t, err)
+	require.Equal(t, coinStore, coinStoreRes)
+
+	var raw []byte
+	err = client.GetAccountResourceBCS(&raw, "0x1", "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", 0)
+	require.Nil(t, err)
+	require.Len(t, raw, 8+1+2*(8+8+32))
+
+	err = client.GetAccountResourceBCS(&coinStoreRes, "0x1", "0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>", 0)
+	require.Equal(t, 404, err.(*aptostypes.RestError).Code)
+	require.Equal(t, "Resource not found", err.Error())
+}
+
+func newTestOnChainTransaction(t *testing.T, version uint64) txBuilder.TransactionOnChainData {
+	sender, err := txBuilder.NewAccountAddressFromHex("0x1234")
+	require.Nil(t, err)
+	framework, err := txBuilder.NewAccountAddressFromHex("0x1")
+	require.Nil(t, err)
+	rawTxn := &txBuilder.RawTransaction{
+		Sender:         *sender,
+		SequenceNumber: version,
+		Payload: txBuilder.TransactionPayloadEntryFunction{
+			ModuleName:   txBuilder.ModuleId{Address: *framework, Name: "aptos_account"},
+			FunctionName: "transfer",
+			TyArgs:       []txBuilder.TypeTag{},
+			Args:         [][]byte{make([]byte, 32), txBuilder.BCSSerializeBasicValue(uint64(100))},
+		},
+		MaxGasAmount:            2000,
+		GasUnitPrice:            100,
+		ExpirationTimestampSecs: 1660000000,
+		ChainId:                 4,
+	}
+	coinType, err := txBuilder.NewTypeTagStructFromString("0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>")
+	require.Nil(t, err)
+	statusCode := uint64(4016)
+	return txBuilder.TransactionOnChainData{
+		Version: version,
+		Transaction: txBuilder.TransactionUser{
+			Transaction: rawTxn,
+			Authenticator: txBuilder.TransactionAuthenticatorEd25519{
+				PublicKey: txBuilder.Ed25519PublicKey{PublicKey: make([]byte, 32)},
+				Signature: txBuilder.Ed25519Signature{Signature: make([]byte, 64)},
+			},
+		},
+		Info: txBuilder.TransactionInfoV0{
+			GasUsed:             6,
+			Status:              txBuilder.ExecutionStatusMiscellaneousError{StatusCode: &statusCode},
+			TransactionHash:     [32]byte{1},
+			StateCheckpointHash: &[32]byte{2},
+		},
+		Events: []txBuilder.ContractEvent{
+			txBuilder.ContractEventV1{
+				Key:       txBuilder.EventKey{CreationNumber: 2, AccountAddress: rawTxn.Sender},
+				TypeTag:   txBuilder.TypeTagU64{},
+				EventData: txBuilder.BCSSerializeBasicValue(uint64(100)),
+			},
+			txBuilder.ContractEventV2{TypeTag: *coinType, EventData: []byte{1}},
+		},
+		Changes: txBuilder.WriteSetV0{WriteSet: []txBuilder.WriteSetEntry{
+			{StateKey: txBuilder.StateKeyAccessPath{Address: rawTxn.Sender, Path: []byte{1}}, WriteOp: txBuilder.WriteOpModification{Data: []byte{2}}},
+			{StateKey: txBuilder.StateKeyTableItem{Key: []byte{3}}, WriteOp: txBuilder.WriteOpDeletion{}},
+			{StateKey: txBuilder.StateKeyRaw{Bytes: []byte{4}}, WriteOp: txBuilder.WriteOpCreationWithMetadata{
+				Data:     []byte{5},
+				Metadata: txBuilder.StateValueMetadataV1{SlotDeposit: 1, BytesDeposit: 2, CreationTimeUsecs: 3},
+			}},
+		}},
+	}
+}
+
+func TestGetTransactionsWithBCS(t *testing.T) {
+	committed := newTestOnChainTransaction(t, 5)
+	pending := txBuilder.TransactionDataPending(committed.Transaction.(txBuilder.TransactionUser))
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, BCSContentType, r.Header.Get("Accept"))
+		var data []byte
+		var err error
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/transactions/by_version/5"):
+			var txn txBuilder.TransactionData = txBuilder.TransactionDataOnChain(committed)
+			data, err = lcs.Marshal(&txn)
+		case strings.HasSuffix(r.URL.Path, "/transactions/by_hash/0xpending"):
+			var txn txBuilder.TransactionData = pending
+			data, err = lcs.Marshal(&txn)
+		case strings.HasSuffix(r.URL.Path, "/accounts/0x1234/transactions"), strings.HasSuffix(r.URL.Path, "/transactions"):
+			data, err = lcs.Marshal([]txBuilder.TransactionOnChainData{committed, newTestOnChainTransaction(t, 6)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Transaction not found","error_code":"transaction_not_found"}`))
+			return
+		}
+		require.Nil(t, err)
+		w.Write(data)
+	})
+
+	var txn txBuilder.TransactionData
+	require.Nil(t, client.GetTransactionByVersionBCS(&txn, "5"))
+	require.Equal(t, txBuilder.TransactionDataOnChain(committed), txn)
+
+	require.Nil(t, client.GetTransactionByHashBCS(&txn, "0xpending"))
+	require.Equal(t, pending, txn)
+
+	var txns []txBuilder.TransactionOnChainData
+	require.Nil(t, client.GetTransactionsBCS(&txns, 5, 2))
+	require.Len(t, txns, 2)
+	require.Equal(t, committed, txns[0])
+	require.Equal(t, uint64(6), txns[1].Version)
+
+	txns = nil
+	require.Nil(t, client.GetAccountTransactionsBCS(&txns, "0x1234", 5, 2))
+	require.Len(t, txns, 2)
+	user := txns[1].Transaction.(txBuilder.TransactionUser)
+	require.Equal(t, uint64(6), user.Transaction.SequenceNumber)
+
+	err := client.GetTransactionByHashBCS(&txn, "0xunknown")
+	require.Equal(t, 404, err.(*aptostypes.RestError).Code)
+}
+
+func TestDecodeUnsupportedTransaction(t *testing.T) {
+	var txn txBuilder.Transaction
+	err := lcs.Unmarshal([]byte{1}, &txn)
+	require.NotNil(t, err)
+}
@@ -1,6 +1,7 @@
 package aptosclient
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
@@ -8,7 +9,11 @@ import (
 )
 
 func (c *RestClient) GetBlockByHeight(height string, with_transactions bool) (block *aptostypes.Block, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/blocks/by_height/"+height, nil)
+	return c.GetBlockByHeightWithContext(context.Background(), height, with_transactions)
+}
+
+func (c *RestClient) GetBlockByHeightWithContext(ctx context.Context, height string, with_transactions bool) (block *aptostypes.Block, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/blocks/by_height/"+height, nil)
 	if err != nil {
 		return
 	}
@@ -21,7 +26,11 @@ func (c *RestClient) GetBlockByHeight(height string, with_transactions bool) (bl
 }
 
 func (c *RestClient) GetBlockByVersion(version string, with_transactions bool) (block *aptostypes.Block, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/blocks/by_version/"+version, nil)
+	return c.GetBlockByVersionWithContext(context.Background(), version, with_transactions)
+}
+
+func (c *RestClient) GetBlockByVersionWithContext(ctx context.Context, version string, with_transactions bool) (block *aptostypes.Block, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/blocks/by_version/"+version, nil)
 	if err != nil {
 		return
 	}
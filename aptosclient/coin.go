@@ -1,6 +1,7 @@
 package aptosclient
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -9,19 +10,29 @@ import (
 )
 
 func (c *RestClient) GetCoinInfo(coinType string) (aptostypes.CoinInfo, error) {
+	return c.GetCoinInfoWithContext(context.Background(), coinType)
+}
+
+func (c *RestClient) GetCoinInfoWithContext(ctx context.Context, coinType string) (aptostypes.CoinInfo, error) {
 	i := strings.Index(coinType, "::")
 	if i < 0 {
 		return aptostypes.CoinInfo{}, errors.New("invalid coin type")
 	}
 
 	address := coinType[:i]
-	resource, err := c.GetAccountResource(address, fmt.Sprintf("0x1::coin::CoinInfo<%s>", coinType), 0)
+	resource, err := GetAccountResourceAsWithContext[coinInfoResource](ctx, c, address, fmt.Sprintf("0x1::coin::CoinInfo<%s>", coinType), 0)
 	if err != nil {
 		return aptostypes.CoinInfo{}, err
 	}
-	coinInfo := aptostypes.CoinInfo{}
-	coinInfo.Decimals = int(resource.Data["decimals"].(float64))
-	coinInfo.Name = resource.Data["name"].(string)
-	coinInfo.Symbol = resource.Data["symbol"].(string)
-	return coinInfo, nil
+	return aptostypes.CoinInfo{
+		Decimals: int(resource.Decimals),
+		Name:     resource.Name,
+		Symbol:   resource.Symbol,
+	}, nil
+}
+
+type coinInfoResource struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
 }
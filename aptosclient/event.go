@@ -1,6 +1,7 @@
 package aptosclient
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 
@@ -8,7 +9,11 @@ import (
 )
 
 func (c *RestClient) GetEventsByEventHandle(address, eventHandle, field string, start, limit uint64) (res []aptostypes.Event, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/events/"+eventHandle+"/"+field, nil)
+	return c.GetEventsByEventHandleWithContext(context.Background(), address, eventHandle, field, start, limit)
+}
+
+func (c *RestClient) GetEventsByEventHandleWithContext(ctx context.Context, address, eventHandle, field string, start, limit uint64) (res []aptostypes.Event, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/events/"+eventHandle+"/"+field, nil)
 	if err != nil {
 		return
 	}
@@ -21,7 +26,11 @@ func (c *RestClient) GetEventsByEventHandle(address, eventHandle, field string,
 }
 
 func (c *RestClient) GetEventsByCreationNumber(address string, creationNumber string, start, limit uint64) (res []aptostypes.Event, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/events/"+creationNumber, nil)
+	return c.GetEventsByCreationNumberWithContext(context.Background(), address, creationNumber, start, limit)
+}
+
+func (c *RestClient) GetEventsByCreationNumberWithContext(ctx context.Context, address string, creationNumber string, start, limit uint64) (res []aptostypes.Event, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+address+"/events/"+creationNumber, nil)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package aptosclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -14,11 +15,15 @@ import (
  * @returns Hashes of submitted transactions
  */
 func FaucetFundAccount(address string, amount uint64, faucetUrl string) (hashs []string, err error) {
+	return FaucetFundAccountWithContext(context.Background(), address, amount, faucetUrl)
+}
+
+func FaucetFundAccountWithContext(ctx context.Context, address string, amount uint64, faucetUrl string) (hashs []string, err error) {
 	if len(faucetUrl) == 0 {
 		faucetUrl = "https://faucet.devnet.aptoslabs.com"
 	}
 	url := fmt.Sprintf("%v/mint?address=%v&amount=%v", faucetUrl, address, amount)
-	req, err := http.NewRequest("POST", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 	if err != nil {
 		return
 	}
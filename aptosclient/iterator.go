@@ -0,0 +1,170 @@
+package aptosclient
+
+import (
+	"context"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+)
+
+const DefaultPageSize = 100
+
+// PageFetcher fetches at most `limit` items starting from `start`
+type PageFetcher[T any] func(ctx context.Context, start, limit uint64) ([]T, error)
+
+// PageIterator lazily walks a paginated endpoint page by page.
+//
+//	it := client.IterateAccountTransactions(ctx, address, 0, 0, 0)
+//	for it.Next() {
+//		txn := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type PageIterator[T any] struct {
+	ctx      context.Context
+	fetch    PageFetcher[T]
+	cursor   uint64 // the start of the next item
+	end      uint64 // exclusive, 0 means unbounded
+	pageSize uint64
+
+	page    []T
+	index   int
+	current T
+	err     error
+	done    bool
+
+	// resolveEnd is called before the first fetch if `end` is unbounded.
+	resolveEnd func(ctx context.Context) (uint64, error)
+}
+
+/**
+ * Creates an iterator over any paginated endpoint.
+ * @param start The position of the first item
+ * @param end The exclusive upper bound of the positions, 0 means iterating until an empty page is returned
+ * @param pageSize The limit of each request, 0 means `DefaultPageSize`
+ */
+func NewPageIterator[T any](ctx context.Context, fetch PageFetcher[T], start, end, pageSize uint64) *PageIterator[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	return &PageIterator[T]{
+		ctx:      ctx,
+		fetch:    fetch,
+		cursor:   start,
+		end:      end,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next item, it returns false when iteration stopped
+// because the range is exhausted or an error occurred.
+func (it *PageIterator[T]) Next() bool {
+	if it.done {
+		return false
+	}
+	if it.index >= len(it.page) {
+		if !it.fetchPage() {
+			it.done = true
+			return false
+		}
+	}
+	it.current = it.page[it.index]
+	it.index++
+	it.cursor++
+	return true
+}
+
+func (it *PageIterator[T]) fetchPage() bool {
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if it.end == 0 && it.resolveEnd != nil {
+		end, err := it.resolveEnd(it.ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.end = end
+		it.resolveEnd = nil
+	}
+	limit := it.pageSize
+	if it.end != 0 {
+		if it.cursor >= it.end {
+			return false
+		}
+		if it.end-it.cursor < limit {
+			limit = it.end - it.cursor
+		}
+	}
+	page, err := it.fetch(it.ctx, it.cursor, limit)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if len(page) == 0 {
+		return false
+	}
+	it.page = page
+	it.index = 0
+	return true
+}
+
+// Value returns the current item
+func (it *PageIterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+// Cursor returns the position of the next item, it can be used to resume iteration later
+func (it *PageIterator[T]) Cursor() uint64 {
+	return it.cursor
+}
+
+/**
+ * Iterates over transactions by version.
+ * If `end` is 0, the iteration stops at the ledger head when the first page is requested.
+ */
+func (c *RestClient) IterateTransactions(ctx context.Context, start, end, pageSize uint64) *PageIterator[aptostypes.Transaction] {
+	it := NewPageIterator(ctx, c.GetTransactionsWithContext, start, end, pageSize)
+	it.resolveEnd = func(ctx context.Context) (uint64, error) {
+		ledger, err := c.LedgerInfoWithContext(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return ledger.LedgerVersion + 1, nil
+	}
+	return it
+}
+
+// IterateAccountTransactions iterates over the transactions sent by account, by sequence number
+func (c *RestClient) IterateAccountTransactions(ctx context.Context, account string, start, end, pageSize uint64) *PageIterator[aptostypes.Transaction] {
+	fetch := func(ctx context.Context, start, limit uint64) ([]aptostypes.Transaction, error) {
+		return c.GetAccountTransactionsWithContext(ctx, account, start, limit)
+	}
+	return NewPageIterator(ctx, fetch, start, end, pageSize)
+}
+
+// IterateEventsByEventHandle iterates over the events of an event handle, by event sequence number
+func (c *RestClient) IterateEventsByEventHandle(ctx context.Context, address, eventHandle, field string, start, end, pageSize uint64) *PageIterator[aptostypes.Event] {
+	fetch := func(ctx context.Context, start, limit uint64) ([]aptostypes.Event, error) {
+		return c.GetEventsByEventHandleWithContext(ctx, address, eventHandle, field, start, limit)
+	}
+	return NewPageIterator(ctx, fetch, start, end, pageSize)
+}
+
+// IterateEventsByCreationNumber iterates over the events of the event handle created with creationNumber, by event sequence number
+func (c *RestClient) IterateEventsByCreationNumber(ctx context.Context, address, creationNumber string, start, end, pageSize uint64) *PageIterator[aptostypes.Event] {
+	fetch := func(ctx context.Context, start, limit uint64) ([]aptostypes.Event, error) {
+		return c.GetEventsByCreationNumberWithContext(ctx, address, creationNumber, start, limit)
+	}
+	return NewPageIterator(ctx, fetch, start, end, pageSize)
+}
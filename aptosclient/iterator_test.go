@@ -0,0 +1,79 @@
+package aptosclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockTransactions serves `count` transactions with versions [0, count)
+func mockTransactions(count uint64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := strconv.ParseUint(q.Get("start"), 10, 64)
+		limit, _ := strconv.ParseUint(q.Get("limit"), 10, 64)
+		w.Write([]byte("["))
+		for v := start; v < start+limit && v < count; v++ {
+			if v > start {
+				w.Write([]byte(","))
+			}
+			fmt.Fprintf(w, `{"type":"user_transaction","version":"%d","sequence_number":"%d"}`, v, v)
+		}
+		w.Write([]byte("]"))
+	}
+}
+
+func TestIterateAccountTransactions(t *testing.T) {
+	client := MockClient(t, mockTransactions(25))
+
+	it := client.IterateAccountTransactions(context.Background(), "0x1", 3, 0, 10)
+	next := uint64(3)
+	for it.Next() {
+		require.Equal(t, next, it.Value().SequenceNumber)
+		next++
+	}
+	require.Nil(t, it.Err())
+	require.Equal(t, uint64(25), next)
+	require.Equal(t, uint64(25), it.Cursor())
+
+	it = client.IterateAccountTransactions(context.Background(), "0x1", 0, 12, 5)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	require.Equal(t, 12, count)
+}
+
+func TestIterateTransactionsStopAtLedgerHead(t *testing.T) {
+	// the mock ledger version is 100
+	client := MockClient(t, mockTransactions(1000))
+
+	it := client.IterateTransactions(context.Background(), 90, 0, 0)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	require.Nil(t, it.Err())
+	require.Equal(t, 11, count)
+}
+
+func TestIteratorCanceled(t *testing.T) {
+	client := MockClient(t, mockTransactions(1000))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	it := client.IterateAccountTransactions(ctx, "0x1", 0, 0, 10)
+	count := 0
+	for it.Next() {
+		count++
+		if count == 15 {
+			cancel()
+		}
+	}
+	require.ErrorIs(t, it.Err(), context.Canceled)
+	require.Equal(t, 20, count)
+}
@@ -0,0 +1,67 @@
+package aptosclient
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+)
+
+const (
+	MultisigAccountResourceType     = "0x1::multisig_account::MultisigAccount"
+	MultisigTransactionType         = "0x1::multisig_account::MultisigTransaction"
+	multisigTransactionTableKeyType = "u64"
+)
+
+// PendingMultisigTransaction is a multisig transaction which has not been executed or rejected
+type PendingMultisigTransaction struct {
+	SequenceNumber uint64
+	aptostypes.MultisigTransaction
+}
+
+func (c *RestClient) GetMultisigAccount(address string) (*aptostypes.MultisigAccount, error) {
+	return c.GetMultisigAccountWithContext(context.Background(), address)
+}
+
+func (c *RestClient) GetMultisigAccountWithContext(ctx context.Context, address string) (*aptostypes.MultisigAccount, error) {
+	return GetAccountResourceAsWithContext[aptostypes.MultisigAccount](ctx, c, address, MultisigAccountResourceType, 0)
+}
+
+// GetMultisigTransaction returns the transaction of multisig account by its sequence number
+func (c *RestClient) GetMultisigTransaction(account *aptostypes.MultisigAccount, sequenceNumber uint64) (*aptostypes.MultisigTransaction, error) {
+	return c.GetMultisigTransactionWithContext(context.Background(), account, sequenceNumber)
+}
+
+func (c *RestClient) GetMultisigTransactionWithContext(ctx context.Context, account *aptostypes.MultisigAccount, sequenceNumber uint64) (*aptostypes.MultisigTransaction, error) {
+	res := &aptostypes.MultisigTransaction{}
+	err := c.GetTableItemWithContext(ctx, res, account.Transactions.Handle, TableItemRequest{
+		KeyType:   multisigTransactionTableKeyType,
+		ValueType: MultisigTransactionType,
+		Key:       strconv.FormatUint(sequenceNumber, 10),
+	}, "")
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetPendingMultisigTransactions lists the transactions that are waiting for approvals or execution, with their votes
+func (c *RestClient) GetPendingMultisigTransactions(address string) ([]*PendingMultisigTransaction, error) {
+	return c.GetPendingMultisigTransactionsWithContext(context.Background(), address)
+}
+
+func (c *RestClient) GetPendingMultisigTransactionsWithContext(ctx context.Context, address string) ([]*PendingMultisigTransaction, error) {
+	account, err := c.GetMultisigAccountWithContext(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	res := []*PendingMultisigTransaction{}
+	for seq := uint64(account.LastExecutedSequenceNumber) + 1; seq < uint64(account.NextSequenceNumber); seq++ {
+		txn, err := c.GetMultisigTransactionWithContext(ctx, account, seq)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &PendingMultisigTransaction{SequenceNumber: seq, MultisigTransaction: *txn})
+	}
+	return res, nil
+}
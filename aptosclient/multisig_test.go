@@ -0,0 +1,63 @@
+package aptosclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPendingMultisigTransactions(t *testing.T) {
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/accounts/0xabc/resource/"+MultisigAccountResourceType):
+			w.Write([]byte(`{"type":"0x1::multisig_account::MultisigAccount","data":{
+				"owners":["0x1","0x2","0x3"],
+				"num_signatures_required":"2",
+				"metadata":{"data":[]},
+				"transactions":{"handle":"0xhandle"},
+				"last_executed_sequence_number":"3",
+				"next_sequence_number":"6",
+				"signer_cap":{"vec":[]}
+			}}`))
+		case strings.HasSuffix(r.URL.Path, "/tables/0xhandle/item"):
+			body := TableItemRequest{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "u64", body.KeyType)
+			require.Equal(t, MultisigTransactionType, body.ValueType)
+			switch body.Key {
+			case "4":
+				w.Write([]byte(`{"payload":{"vec":["0x00"]},"payload_hash":{"vec":[]},"votes":{"data":[{"key":"0x2","value":true},{"key":"0x1","value":true},{"key":"0x3","value":false}]},"creator":"0x1","creation_time_secs":"1680000000"}`))
+			case "5":
+				w.Write([]byte(`{"payload":{"vec":[]},"payload_hash":{"vec":["0x1234"]},"votes":{"data":[{"key":"0x1","value":true}]},"creator":"0x1","creation_time_secs":"1680000001"}`))
+			default:
+				t.Fatalf("unexpected key %v", body.Key)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found","error_code":"resource_not_found"}`))
+		}
+	})
+
+	txns, err := client.GetPendingMultisigTransactions("0xabc")
+	require.Nil(t, err)
+	require.Len(t, txns, 2)
+	require.Equal(t, uint64(4), txns[0].SequenceNumber)
+	require.Equal(t, []string{"0x1", "0x2"}, txns[0].Approvals())
+	require.Equal(t, []string{"0x3"}, txns[0].Rejections())
+	payload, ok := txns[0].Payload.Value()
+	require.True(t, ok)
+	require.Equal(t, "0x00", payload)
+
+	require.Equal(t, uint64(5), txns[1].SequenceNumber)
+	_, ok = txns[1].Payload.Value()
+	require.False(t, ok)
+	hash, _ := txns[1].PayloadHash.Value()
+	require.Equal(t, "0x1234", hash)
+	require.Equal(t, uint64(1680000001), uint64(txns[1].CreationTimeSecs))
+
+	_, err = client.GetPendingMultisigTransactions("0xdef")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,85 @@
+package aptosclient
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// TypedAccountResource is an account resource whose data is unmarshaled into T.
+// The Move u64, u128 and u256 fields are JSON strings, use `aptostypes.U64`, `aptostypes.U128`
+// or `string` for them in T.
+type TypedAccountResource[T any] struct {
+	Type string `json:"type"`
+	Data T      `json:"data"`
+}
+
+/**
+ * Variation of `GetAccountResource`, the resource data is unmarshaled into caller defined T.
+ * @example
+ * ```
+ * type CoinStore struct {
+ *	Coin struct {
+ *		Value aptostypes.U64 `json:"value"`
+ *	} `json:"coin"`
+ *	Frozen bool `json:"frozen"`
+ * }
+ * store, err := aptosclient.GetAccountResourceAs[CoinStore](client, address, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", 0)
+ * ```
+ */
+func GetAccountResourceAs[T any](c *RestClient, address, resourceType string, version uint64) (*T, error) {
+	return GetAccountResourceAsWithContext[T](context.Background(), c, address, resourceType, version)
+}
+
+func GetAccountResourceAsWithContext[T any](ctx context.Context, c *RestClient, address, resourceType string, version uint64) (*T, error) {
+	req, err := c.newAccountResourceRequest(ctx, address, resourceType, version)
+	if err != nil {
+		return nil, err
+	}
+	res := TypedAccountResource[T]{}
+	err = c.doReq(req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Data, nil
+}
+
+/**
+ * Lists the account resources of the struct, the type arguments of resource type are ignored when matching,
+ * so `0x1::coin::CoinStore` will match all the `0x1::coin::CoinStore<T>` of the account.
+ * @param structName The struct without type arguments, e.g. `0x1::coin::CoinStore`
+ */
+func GetAccountResourcesAs[T any](c *RestClient, address, structName string, version uint64) ([]TypedAccountResource[T], error) {
+	return GetAccountResourcesAsWithContext[T](context.Background(), c, address, structName, version)
+}
+
+func GetAccountResourcesAsWithContext[T any](ctx context.Context, c *RestClient, address, structName string, version uint64) ([]TypedAccountResource[T], error) {
+	req, err := c.newAccountResourcesRequest(ctx, address, version)
+	if err != nil {
+		return nil, err
+	}
+	resources := []TypedAccountResource[json.RawMessage]{}
+	err = c.doReq(req, &resources)
+	if err != nil {
+		return nil, err
+	}
+	res := []TypedAccountResource[T]{}
+	for _, resource := range resources {
+		if resourceStructName(resource.Type) != structName {
+			continue
+		}
+		typed := TypedAccountResource[T]{Type: resource.Type}
+		if err := json.Unmarshal(resource.Data, &typed.Data); err != nil {
+			return nil, err
+		}
+		res = append(res, typed)
+	}
+	return res, nil
+}
+
+func resourceStructName(resourceType string) string {
+	if i := strings.Index(resourceType, "<"); i >= 0 {
+		return resourceType[:i]
+	}
+	return resourceType
+}
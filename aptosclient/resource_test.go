@@ -0,0 +1,59 @@
+package aptosclient
+
+import (
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountResourceAs(t *testing.T) {
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/resource/0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>"):
+			w.Write([]byte(`{"type":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>","data":{"coin":{"value":"18446744073709551615"},"frozen":false}}`))
+		case strings.HasSuffix(r.URL.Path, "/resource/0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>"):
+			w.Write([]byte(`{"type":"0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>","data":{"decimals":8,"name":"Aptos Coin","symbol":"APT","supply":{"vec":[]}}}`))
+		case strings.HasSuffix(r.URL.Path, "/resources"):
+			w.Write([]byte(`[
+				{"type":"0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>","data":{"coin":{"value":"100"},"frozen":false}},
+				{"type":"0x1::account::Account","data":{"sequence_number":"1"}},
+				{"type":"0x1::coin::CoinStore<0x1::test::Coin>","data":{"coin":{"value":"200"},"frozen":true}}
+			]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Resource not found","error_code":"resource_not_found"}`))
+		}
+	})
+
+	type CoinStore struct {
+		Coin struct {
+			Value aptostypes.U64 `json:"value"`
+		} `json:"coin"`
+		Frozen bool `json:"frozen"`
+	}
+	store, err := GetAccountResourceAs[CoinStore](client, "0x1", "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", 0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(18446744073709551615), uint64(store.Coin.Value))
+
+	stores, err := GetAccountResourcesAs[CoinStore](client, "0x1", "0x1::coin::CoinStore", 0)
+	require.Nil(t, err)
+	require.Len(t, stores, 2)
+	require.Equal(t, "0x1::coin::CoinStore<0x1::test::Coin>", stores[1].Type)
+	require.Equal(t, uint64(200), uint64(stores[1].Data.Coin.Value))
+	require.True(t, stores[1].Data.Frozen)
+
+	balance, err := client.BalanceOf("0x1", "0x1::aptos_coin::AptosCoin")
+	require.Nil(t, err)
+	require.Equal(t, new(big.Int).SetUint64(18446744073709551615), balance)
+	balance, err = client.BalanceOf("0x1", "0x1::test::Coin")
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0), balance)
+
+	info, err := client.GetCoinInfo("0x1::aptos_coin::AptosCoin")
+	require.Nil(t, err)
+	require.Equal(t, aptostypes.CoinInfo{Decimals: 8, Name: "Aptos Coin", Symbol: "APT"}, info)
+}
@@ -21,6 +21,8 @@ type RestClient struct {
 	c       *http.Client
 	rpcUrl  string
 	version string
+
+	retryPolicy *RetryPolicy
 }
 
 func Dial(ctx context.Context, rpcUrl string) (client *RestClient, err error) {
@@ -35,7 +37,7 @@ func Dial(ctx context.Context, rpcUrl string) (client *RestClient, err error) {
 		},
 		version: VERSION1,
 	}
-	err = client.setChainId()
+	err = client.setChainId(ctx)
 	return
 }
 
@@ -45,7 +47,7 @@ func DialWithClient(ctx context.Context, rpcUrl string, c *http.Client) (client
 		c:       c,
 		version: VERSION1,
 	}
-	err = client.setChainId()
+	err = client.setChainId(ctx)
 	return
 }
 
@@ -62,7 +64,11 @@ func (c *RestClient) GetVersionedRpcUrl() string {
 }
 
 func (c *RestClient) LedgerInfo() (res *aptostypes.LedgerInfo, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl(), nil)
+	return c.LedgerInfoWithContext(context.Background())
+}
+
+func (c *RestClient) LedgerInfoWithContext(ctx context.Context) (res *aptostypes.LedgerInfo, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl(), nil)
 	if err != nil {
 		return
 	}
@@ -72,8 +78,12 @@ func (c *RestClient) LedgerInfo() (res *aptostypes.LedgerInfo, err error) {
 }
 
 func (c *RestClient) RawQuery(urlWithoutVersion string, params map[string]string) (data []byte, err error) {
+	return c.RawQueryWithContext(context.Background(), urlWithoutVersion, params)
+}
+
+func (c *RestClient) RawQueryWithContext(ctx context.Context, urlWithoutVersion string, params map[string]string) (data []byte, err error) {
 	urlWithoutVersion = "/" + strings.TrimPrefix(urlWithoutVersion, "/")
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+urlWithoutVersion, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+urlWithoutVersion, nil)
 	if err != nil {
 		return
 	}
@@ -83,10 +93,11 @@ func (c *RestClient) RawQuery(urlWithoutVersion string, params map[string]string
 			q.Add(k, v)
 		}
 	}
-	resp, err := c.c.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return
 	}
+	defer resp.Body.Close()
 	data, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return
@@ -100,8 +111,8 @@ func (c *RestClient) RawQuery(urlWithoutVersion string, params map[string]string
 	return
 }
 
-func (c *RestClient) setChainId() (err error) {
-	ledger, err := c.LedgerInfo()
+func (c *RestClient) setChainId(ctx context.Context) (err error) {
+	ledger, err := c.LedgerInfoWithContext(ctx)
 	if err != nil {
 		return
 	}
@@ -115,7 +126,20 @@ func (c *RestClient) ChainId() int {
 
 // doReq send request and unmarshal response body to result
 func (c *RestClient) doReq(req *http.Request, result interface{}) error {
-	return doReqWithClient(req, result, c.c)
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return handleResponse(result, resp)
+}
+
+// do send request, the request will be retried according to the retry policy if it's set
+func (c *RestClient) do(req *http.Request) (*http.Response, error) {
+	if c.retryPolicy == nil {
+		return c.c.Do(req)
+	}
+	return c.retryPolicy.do(c.c, req)
 }
 
 func doReqWithClient(req *http.Request, result interface{}, client *http.Client) error {
@@ -1,8 +1,11 @@
 package aptosclient
 
 import (
+	"context"
+	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -31,3 +34,20 @@ func TestTransactionDetail(t *testing.T) {
 	require.Equal(t, tx2Version, version, "Transaction's version and hash not match.")
 	require.Equal(t, tx1.Hash, tx2.Hash)
 }
+
+func TestRequestWithCanceledContext(t *testing.T) {
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	require.Equal(t, 4, client.ChainId())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.GetAccountWithContext(ctx, "0x1")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = client.AptosBalanceOfWithContext(ctx, "0x1")
+	require.ErrorIs(t, err, context.Canceled)
+}
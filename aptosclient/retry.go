@@ -0,0 +1,162 @@
+package aptosclient
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how `RestClient` retries requests that failed with a transient error.
+// A request is retried when it fails at the transport level, or when the server responds
+// with a status code accepted by `RetryableStatus`.
+type RetryPolicy struct {
+	// The maximum number of retries after the first attempt.
+	MaxRetries int
+	// The delay before the first retry.
+	InitialBackoff time.Duration
+	// The upper limit of the delay, also applies to the `Retry-After` header.
+	MaxBackoff time.Duration
+	// The delay will be multiplied by Multiplier after each retry.
+	Multiplier float64
+	// Randomization factor between 0 and 1, the actual delay is chosen from [delay*(1-Jitter), delay].
+	Jitter float64
+	// Reports whether a response status code can be retried, nil to use `IsRetryableStatus`.
+	RetryableStatus func(code int) bool
+	// By default only idempotent requests (GET, HEAD, OPTIONS) are retried.
+	// Set true to also retry POST requests, such as transaction submissions.
+	RetryNonIdempotent bool
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// IsRetryableStatus returns true for 429 Too Many Requests and the 5xx status codes which are usually transient.
+func IsRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// SetRetryPolicy sets the retry policy of the client, nil disables retrying.
+func (c *RestClient) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+func (c *RestClient) GetRetryPolicy() *RetryPolicy {
+	return c.retryPolicy
+}
+
+func (p *RetryPolicy) canRetryRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return p.RetryNonIdempotent && (req.Body == nil || req.GetBody != nil)
+}
+
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	if p.RetryableStatus != nil {
+		return p.RetryableStatus(code)
+	}
+	return IsRetryableStatus(code)
+}
+
+// backoff returns the delay before the retry with index `attempt` (starts at 0)
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(math.Max(p.Multiplier, 1), float64(attempt))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// retryAfter parses the `Retry-After` header, which can be either seconds or a http date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+func (p *RetryPolicy) do(client *http.Client, req *http.Request) (resp *http.Response, err error) {
+	if !p.canRetryRequest(req) {
+		return client.Do(req)
+	}
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		resp, err = client.Do(req)
+		if attempt >= p.MaxRetries {
+			return
+		}
+		if err != nil {
+			if req.Context().Err() != nil {
+				return
+			}
+		} else if !p.isRetryableStatus(resp.StatusCode) {
+			return
+		}
+
+		delay := p.backoff(attempt)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				delay = after
+				if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+					delay = p.MaxBackoff
+				}
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if err = sleepWithContext(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
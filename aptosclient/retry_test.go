@@ -0,0 +1,84 @@
+package aptosclient
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	return policy
+}
+
+func TestRetryPolicy_TransientStatus(t *testing.T) {
+	var attempts int32
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"sequence_number":"5","authentication_key":"0x1"}`))
+	})
+
+	_, err := client.GetAccount("0x1")
+	require.Equal(t, http.StatusServiceUnavailable, err.(*aptostypes.RestError).Code)
+
+	atomic.StoreInt32(&attempts, 0)
+	client.SetRetryPolicy(testRetryPolicy())
+	account, err := client.GetAccount("0x1")
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), account.SequenceNumber)
+	require.Equal(t, int32(3), attempts)
+}
+
+func TestRetryPolicy_RetryAfter(t *testing.T) {
+	var attempts int32
+	var firstAttempt time.Time
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"sequence_number":"5","authentication_key":"0x1"}`))
+	})
+	client.SetRetryPolicy(testRetryPolicy())
+
+	_, err := client.GetAccount("0x1")
+	require.Nil(t, err)
+	require.GreaterOrEqual(t, time.Since(firstAttempt), time.Second)
+}
+
+func TestRetryPolicy_NonIdempotent(t *testing.T) {
+	var attempts int32
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Equal(t, []byte{1, 2, 3}, body)
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"type":"pending_transaction","hash":"0x1234"}`))
+	})
+	client.SetRetryPolicy(testRetryPolicy())
+
+	_, err := client.SubmitSignedBCSTransaction([]byte{1, 2, 3})
+	require.NotNil(t, err)
+	require.Equal(t, int32(1), attempts)
+
+	atomic.StoreInt32(&attempts, 0)
+	client.GetRetryPolicy().RetryNonIdempotent = true
+	txn, err := client.SubmitSignedBCSTransaction([]byte{1, 2, 3})
+	require.Nil(t, err)
+	require.Equal(t, "0x1234", txn.Hash)
+	require.Equal(t, int32(2), attempts)
+}
@@ -0,0 +1,48 @@
+package aptosclient
+
+import (
+	"context"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+)
+
+const (
+	OriginatingAddressResourceType = "0x1::account::OriginatingAddress"
+	// The table `address_map` maps the authentication key (address) to the originating address (address)
+	originatingAddressTableKeyType   = "address"
+	originatingAddressTableValueType = "address"
+)
+
+// originatingAddressResource is the resource `0x1::account::OriginatingAddress` under 0x1
+type originatingAddressResource struct {
+	AddressMap aptostypes.TableHandle `json:"address_map"`
+}
+
+/**
+ * GetOriginatingAddress returns the address of the account whose authentication key has been rotated to authKey,
+ * as `0x1::account::OriginatingAddress`.
+ * If the key is not in the table, the account has never been rotated and the authKey itself is returned.
+ */
+func (c *RestClient) GetOriginatingAddress(authKey string) (string, error) {
+	return c.GetOriginatingAddressWithContext(context.Background(), authKey)
+}
+
+func (c *RestClient) GetOriginatingAddressWithContext(ctx context.Context, authKey string) (string, error) {
+	resource, err := GetAccountResourceAsWithContext[originatingAddressResource](ctx, c, "0x1", OriginatingAddressResourceType, 0)
+	if err != nil {
+		return "", err
+	}
+	address := ""
+	err = c.GetTableItemWithContext(ctx, &address, resource.AddressMap.Handle, TableItemRequest{
+		KeyType:   originatingAddressTableKeyType,
+		ValueType: originatingAddressTableValueType,
+		Key:       authKey,
+	}, "")
+	if e, ok := err.(*aptostypes.RestError); ok && e.Code == 404 {
+		return authKey, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return address, nil
+}
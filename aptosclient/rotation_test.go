@@ -0,0 +1,41 @@
+package aptosclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOriginatingAddress(t *testing.T) {
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/accounts/0x1/resource/"+OriginatingAddressResourceType):
+			w.Write([]byte(`{"type":"0x1::account::OriginatingAddress","data":{"address_map":{"handle":"0xhandle"}}}`))
+		case strings.HasSuffix(r.URL.Path, "/tables/0xhandle/item"):
+			body := TableItemRequest{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "address", body.KeyType)
+			require.Equal(t, "address", body.ValueType)
+			if body.Key == "0xrotated" {
+				w.Write([]byte(`"0xoriginal"`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Table Item not found","error_code":"table_item_not_found"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"not found","error_code":"resource_not_found"}`))
+		}
+	})
+
+	address, err := client.GetOriginatingAddress("0xrotated")
+	require.Nil(t, err)
+	require.Equal(t, "0xoriginal", address)
+
+	address, err = client.GetOriginatingAddress("0xnew")
+	require.Nil(t, err)
+	require.Equal(t, "0xnew", address)
+}
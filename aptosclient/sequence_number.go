@@ -0,0 +1,198 @@
+package aptosclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultMaxInFlight          = 100
+	DefaultSequencePollInterval = 100 * time.Millisecond
+	DefaultSequenceStallTimeout = 30 * time.Second
+)
+
+type SequenceNumberOptions struct {
+	// The maximum number of uncommitted transactions, default is `DefaultMaxInFlight`.
+	MaxInFlight uint64
+	// The interval to poll the on-chain sequence number while waiting, default is `DefaultSequencePollInterval`.
+	PollInterval time.Duration
+	// If the on-chain sequence number has not advanced for this duration while waiting,
+	// the in-flight transactions are considered lost or expired and the manager resyncs from chain.
+	// Default is `DefaultSequenceStallTimeout`.
+	StallTimeout time.Duration
+}
+
+// SequenceNumberManager hands out sequence numbers of an account locally,
+// so that many transactions can be submitted without querying the account each time.
+// It's safe for concurrent use.
+//
+// Call `Resync` when a submission failed or a transaction expired,
+// so that the following transactions reuse the sequence numbers that were not committed.
+type SequenceNumberManager struct {
+	client  *RestClient
+	address string
+	options SequenceNumberOptions
+
+	mu          sync.Mutex
+	initialized bool
+	onChain     uint64 // the sequence number of account on chain, all numbers before it are committed
+	next        uint64 // the next sequence number to be handed out
+	epoch       uint64 // increased by each resync, so the waiters know the local state is discarded
+}
+
+func (c *RestClient) NewSequenceNumberManager(address string, opts *SequenceNumberOptions) *SequenceNumberManager {
+	options := SequenceNumberOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.MaxInFlight == 0 {
+		options.MaxInFlight = DefaultMaxInFlight
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultSequencePollInterval
+	}
+	if options.StallTimeout <= 0 {
+		options.StallTimeout = DefaultSequenceStallTimeout
+	}
+	return &SequenceNumberManager{
+		client:  c,
+		address: address,
+		options: options,
+	}
+}
+
+func (m *SequenceNumberManager) Address() string {
+	return m.address
+}
+
+// Next returns the next sequence number, it blocks if there are already `MaxInFlight` uncommitted transactions.
+func (m *SequenceNumberManager) Next(ctx context.Context) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.initialized {
+		if err := m.resync(ctx, false); err != nil {
+			return 0, err
+		}
+	}
+	if err := m.waitFor(ctx, func() bool { return m.next-m.onChain < m.options.MaxInFlight }); err != nil {
+		return 0, err
+	}
+	number := m.next
+	m.next++
+	return number, nil
+}
+
+// Resync discards the local state and reloads the sequence number from chain.
+func (m *SequenceNumberManager) Resync(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.resync(ctx, true)
+}
+
+// Synchronize waits until all the handed out sequence numbers are committed.
+func (m *SequenceNumberManager) Synchronize(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.initialized {
+		return m.resync(ctx, false)
+	}
+	if err := m.update(ctx); err != nil {
+		return err
+	}
+	return m.waitFor(ctx, func() bool { return m.onChain >= m.next })
+}
+
+// InFlight returns the number of handed out sequence numbers that are not known to be committed.
+func (m *SequenceNumberManager) InFlight() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.next - m.onChain
+}
+
+// waitFor polls the on-chain sequence number until `done` returns true.
+// If the sequence number stalls for `StallTimeout`, it resyncs and returns.
+// It must be called with `mu` held, the lock is released while polling the chain and sleeping.
+func (m *SequenceNumberManager) waitFor(ctx context.Context, done func() bool) error {
+	lastAdvance := time.Now()
+	epoch := m.epoch
+	for !done() {
+		if m.epoch != epoch {
+			// resynced by others, restart the stall timer with the new state
+			epoch = m.epoch
+			lastAdvance = time.Now()
+			continue
+		}
+		if time.Since(lastAdvance) >= m.options.StallTimeout {
+			return m.resync(ctx, true)
+		}
+		previous := m.onChain
+		m.mu.Unlock()
+		sequenceNumber, err := m.sleepAndFetch(ctx)
+		m.mu.Lock()
+		if err != nil {
+			return err
+		}
+		m.advance(sequenceNumber)
+		if m.onChain != previous {
+			lastAdvance = time.Now()
+		}
+	}
+	return nil
+}
+
+func (m *SequenceNumberManager) sleepAndFetch(ctx context.Context) (uint64, error) {
+	if err := sleepWithContext(ctx, m.options.PollInterval); err != nil {
+		return 0, err
+	}
+	return m.fetch(ctx)
+}
+
+// fetch queries the on-chain sequence number, it's called without `mu` held
+func (m *SequenceNumberManager) fetch(ctx context.Context) (uint64, error) {
+	account, err := m.client.GetAccountWithContext(ctx, m.address)
+	if err != nil {
+		return 0, err
+	}
+	return account.SequenceNumber, nil
+}
+
+// advance applies the on-chain sequence number, a stale response of concurrent queries never moves it back
+func (m *SequenceNumberManager) advance(sequenceNumber uint64) {
+	if sequenceNumber > m.onChain {
+		m.onChain = sequenceNumber
+	}
+	if m.next < m.onChain {
+		// transactions are sent by others
+		m.next = m.onChain
+	}
+}
+
+// update reloads the on-chain sequence number, the lock is released during the query
+func (m *SequenceNumberManager) update(ctx context.Context) error {
+	m.mu.Unlock()
+	sequenceNumber, err := m.fetch(ctx)
+	m.mu.Lock()
+	if err != nil {
+		return err
+	}
+	m.advance(sequenceNumber)
+	return nil
+}
+
+// resync reloads the on-chain sequence number and hands out from it again.
+// If force is false, the local state initialized by others during the query is kept.
+func (m *SequenceNumberManager) resync(ctx context.Context, force bool) error {
+	if err := m.update(ctx); err != nil {
+		return err
+	}
+	if !force && m.initialized {
+		return nil
+	}
+	m.next = m.onChain
+	m.initialized = true
+	m.epoch++
+	return nil
+}
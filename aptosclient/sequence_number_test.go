@@ -0,0 +1,156 @@
+package aptosclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mockAccount(sequenceNumber *uint64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"sequence_number":"%d","authentication_key":"0x1"}`, atomic.LoadUint64(sequenceNumber))
+	}
+}
+
+func TestSequenceNumberManager_Concurrent(t *testing.T) {
+	onChain := uint64(10)
+	client := MockClient(t, mockAccount(&onChain))
+	manager := client.NewSequenceNumberManager("0x1", &SequenceNumberOptions{
+		MaxInFlight:  5,
+		PollInterval: time.Millisecond,
+	})
+
+	require.Nil(t, manager.Resync(context.Background()))
+
+	// commit the transactions slowly
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(2 * time.Millisecond):
+				if atomic.LoadUint64(&onChain) < 30 {
+					atomic.AddUint64(&onChain, 1)
+				}
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	numbers := make(map[uint64]bool)
+	errs := make(chan error, 20)
+	inFlights := make(chan uint64, 20)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := manager.Next(context.Background())
+			if err != nil {
+				errs <- err
+				return
+			}
+			inFlights <- manager.InFlight()
+			mu.Lock()
+			numbers[n] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	close(inFlights)
+
+	for err := range errs {
+		require.Nil(t, err)
+	}
+	for inFlight := range inFlights {
+		require.LessOrEqual(t, inFlight, uint64(5))
+	}
+	require.Len(t, numbers, 20)
+	for n := uint64(10); n < 30; n++ {
+		require.True(t, numbers[n])
+	}
+	require.Nil(t, manager.Synchronize(context.Background()))
+	require.Equal(t, uint64(0), manager.InFlight())
+}
+
+func TestSequenceNumberManager_Resync(t *testing.T) {
+	onChain := uint64(3)
+	client := MockClient(t, mockAccount(&onChain))
+	manager := client.NewSequenceNumberManager("0x1", &SequenceNumberOptions{
+		MaxInFlight:  2,
+		PollInterval: time.Millisecond,
+		StallTimeout: 20 * time.Millisecond,
+	})
+
+	for want := uint64(3); want < 5; want++ {
+		n, err := manager.Next(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, want, n)
+	}
+
+	// the submission of 3 and 4 failed
+	require.Nil(t, manager.Resync(context.Background()))
+	n, err := manager.Next(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), n)
+
+	// 3 and 4 are expired, the manager resyncs after the stall timeout
+	_, err = manager.Next(context.Background())
+	require.Nil(t, err)
+	n, err = manager.Next(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), n)
+}
+
+func TestSequenceNumberManager_NotBlockedByWaiting(t *testing.T) {
+	onChain := uint64(3)
+	client := MockClient(t, mockAccount(&onChain))
+	manager := client.NewSequenceNumberManager("0x1", &SequenceNumberOptions{
+		MaxInFlight:  1,
+		PollInterval: time.Millisecond,
+		StallTimeout: time.Minute,
+	})
+	n, err := manager.Next(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), n)
+
+	// the next one waits for 3 to be committed
+	type result struct {
+		n   uint64
+		err error
+	}
+	waiting := make(chan result, 1)
+	go func() {
+		n, err := manager.Next(context.Background())
+		waiting <- result{n, err}
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	inFlight := make(chan uint64, 1)
+	go func() { inFlight <- manager.InFlight() }()
+	select {
+	case n := <-inFlight:
+		require.Equal(t, uint64(1), n)
+	case <-time.After(time.Second):
+		t.Fatal("InFlight is blocked by the waiting Next")
+	}
+
+	// the submission of 3 failed, the waiting one reuses it after resync
+	require.Nil(t, manager.Resync(context.Background()))
+	select {
+	case res := <-waiting:
+		require.Nil(t, res.err)
+		require.Equal(t, uint64(3), res.n)
+	case <-time.After(time.Second):
+		t.Fatal("Next is not woken up by Resync")
+	}
+}
@@ -2,6 +2,7 @@ package aptosclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -34,20 +35,31 @@ type TableItemRequest struct {
  * @throws ApiError
  */
 func (c *RestClient) GetTableItem(out interface{}, handle string, body TableItemRequest, ledgerVersion string) (err error) {
+	return c.GetTableItemWithContext(context.Background(), out, handle, body, ledgerVersion)
+}
+
+func (c *RestClient) GetTableItemWithContext(ctx context.Context, out interface{}, handle string, body TableItemRequest, ledgerVersion string) (err error) {
+	req, err := c.newTableItemRequest(ctx, handle, body, ledgerVersion)
+	if err != nil {
+		return
+	}
+	err = c.doReq(req, out)
+	return
+}
+
+func (c *RestClient) newTableItemRequest(ctx context.Context, handle string, body TableItemRequest, ledgerVersion string) (*http.Request, error) {
 	url := fmt.Sprintf("%v/tables/%v/item", c.GetVersionedRpcUrl(), handle)
 	if ledgerVersion != "" {
 		url = fmt.Sprintf("%v?ledger_version=%v", url, ledgerVersion)
 	}
 	bodyData, err := json.Marshal(body)
 	if err != nil {
-		return
+		return nil, err
 	}
-	req, err := http.NewRequest("POST", url, bytes.NewReader(bodyData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(bodyData))
 	if err != nil {
-		return
+		return nil, err
 	}
 	req.Header["Content-Type"] = []string{"application/json"}
-
-	err = c.doReq(req, out)
-	return
+	return req, nil
 }
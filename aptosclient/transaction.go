@@ -2,6 +2,7 @@ package aptosclient
 
 import (
 	"bytes"
+	"context"
 	"crypto/ed25519"
 	"encoding/hex"
 	"encoding/json"
@@ -13,25 +14,50 @@ import (
 )
 
 func (c *RestClient) GetTransactions(start, limit uint64) (res []aptostypes.Transaction, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/transactions", nil)
+	return c.GetTransactionsWithContext(context.Background(), start, limit)
+}
+
+func (c *RestClient) GetTransactionsWithContext(ctx context.Context, start, limit uint64) (res []aptostypes.Transaction, err error) {
+	req, err := c.newTransactionsRequest(ctx, start, limit)
 	if err != nil {
 		return
 	}
+	err = c.doReq(req, &res)
+	return
+}
+
+func (c *RestClient) newTransactionsRequest(ctx context.Context, start, limit uint64) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/transactions", nil)
+	if err != nil {
+		return nil, err
+	}
 	q := req.URL.Query()
 	q.Add("start", strconv.FormatUint(start, 10))
 	if limit > 0 {
 		q.Add("limit", strconv.FormatUint(limit, 10))
 	}
 	req.URL.RawQuery = q.Encode()
-	err = c.doReq(req, &res)
-	return
+	return req, nil
 }
 
 func (c *RestClient) GetAccountTransactions(account string, start, limit uint64) (res []aptostypes.Transaction, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/accounts/"+account+"/transactions", nil)
+	return c.GetAccountTransactionsWithContext(context.Background(), account, start, limit)
+}
+
+func (c *RestClient) GetAccountTransactionsWithContext(ctx context.Context, account string, start, limit uint64) (res []aptostypes.Transaction, err error) {
+	req, err := c.newAccountTransactionsRequest(ctx, account, start, limit)
 	if err != nil {
 		return
 	}
+	err = c.doReq(req, &res)
+	return
+}
+
+func (c *RestClient) newAccountTransactionsRequest(ctx context.Context, account string, start, limit uint64) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/accounts/"+account+"/transactions", nil)
+	if err != nil {
+		return nil, err
+	}
 	q := req.URL.Query()
 	if start > 0 {
 		q.Add("start", strconv.FormatUint(start, 10))
@@ -40,12 +66,15 @@ func (c *RestClient) GetAccountTransactions(account string, start, limit uint64)
 		q.Add("limit", strconv.FormatUint(limit, 10))
 	}
 	req.URL.RawQuery = q.Encode()
-	err = c.doReq(req, &res)
-	return
+	return req, nil
 }
 
 func (c *RestClient) GetTransactionByHash(txHash string) (res *aptostypes.Transaction, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/transactions/by_hash/"+txHash, nil)
+	return c.GetTransactionByHashWithContext(context.Background(), txHash)
+}
+
+func (c *RestClient) GetTransactionByHashWithContext(ctx context.Context, txHash string) (res *aptostypes.Transaction, err error) {
+	req, err := c.newTransactionByHashRequest(ctx, txHash)
 	if err != nil {
 		return
 	}
@@ -54,8 +83,16 @@ func (c *RestClient) GetTransactionByHash(txHash string) (res *aptostypes.Transa
 	return
 }
 
+func (c *RestClient) newTransactionByHashRequest(ctx context.Context, txHash string) (*http.Request, error) {
+	return http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/transactions/by_hash/"+txHash, nil)
+}
+
 func (c *RestClient) GetTransactionByVersion(txVersion string) (res *aptostypes.Transaction, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/transactions/by_version/"+txVersion, nil)
+	return c.GetTransactionByVersionWithContext(context.Background(), txVersion)
+}
+
+func (c *RestClient) GetTransactionByVersionWithContext(ctx context.Context, txVersion string) (res *aptostypes.Transaction, err error) {
+	req, err := c.newTransactionByVersionRequest(ctx, txVersion)
 	if err != nil {
 		return
 	}
@@ -64,13 +101,21 @@ func (c *RestClient) GetTransactionByVersion(txVersion string) (res *aptostypes.
 	return
 }
 
+func (c *RestClient) newTransactionByVersionRequest(ctx context.Context, txVersion string) (*http.Request, error) {
+	return http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/transactions/by_version/"+txVersion, nil)
+}
+
 /**
  * Submits a signed transaction to the the endpoint that takes BCS payload
  * @param signedTxn A BCS transaction representation
  * @returns Transaction that is accepted and submitted to mempool
  */
 func (c *RestClient) SimulateSignedBCSTransaction(signedTxn []byte) (res []*aptostypes.Transaction, err error) {
-	req, err := http.NewRequest("POST", c.GetVersionedRpcUrl()+"/transactions/simulate", bytes.NewReader(signedTxn))
+	return c.SimulateSignedBCSTransactionWithContext(context.Background(), signedTxn)
+}
+
+func (c *RestClient) SimulateSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) (res []*aptostypes.Transaction, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.GetVersionedRpcUrl()+"/transactions/simulate", bytes.NewReader(signedTxn))
 	if err != nil {
 		return
 	}
@@ -82,7 +127,11 @@ func (c *RestClient) SimulateSignedBCSTransaction(signedTxn []byte) (res []*apto
 }
 
 func (c *RestClient) SimulateTransaction(transaction *aptostypes.Transaction, senderPublicKey string) (res []*aptostypes.Transaction, err error) {
-	signingMessage, err := c.CreateTransactionSigningMessage(transaction)
+	return c.SimulateTransactionWithContext(context.Background(), transaction, senderPublicKey)
+}
+
+func (c *RestClient) SimulateTransactionWithContext(ctx context.Context, transaction *aptostypes.Transaction, senderPublicKey string) (res []*aptostypes.Transaction, err error) {
+	signingMessage, err := c.CreateTransactionSigningMessageWithContext(ctx, transaction)
 	if err != nil {
 		return
 	}
@@ -100,7 +149,7 @@ func (c *RestClient) SimulateTransaction(transaction *aptostypes.Transaction, se
 	if err != nil {
 		return
 	}
-	req, err := http.NewRequest("POST", c.GetVersionedRpcUrl()+"/transactions/simulate", bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.GetVersionedRpcUrl()+"/transactions/simulate", bytes.NewReader(data))
 	if err != nil {
 		return
 	}
@@ -117,7 +166,11 @@ func (c *RestClient) SimulateTransaction(transaction *aptostypes.Transaction, se
  * @returns Transaction that is accepted and submitted to mempool
  */
 func (c *RestClient) SubmitSignedBCSTransaction(signedTxn []byte) (res *aptostypes.Transaction, err error) {
-	req, err := http.NewRequest("POST", c.GetVersionedRpcUrl()+"/transactions", bytes.NewReader(signedTxn))
+	return c.SubmitSignedBCSTransactionWithContext(context.Background(), signedTxn)
+}
+
+func (c *RestClient) SubmitSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) (res *aptostypes.Transaction, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.GetVersionedRpcUrl()+"/transactions", bytes.NewReader(signedTxn))
 	if err != nil {
 		return
 	}
@@ -129,11 +182,15 @@ func (c *RestClient) SubmitSignedBCSTransaction(signedTxn []byte) (res *aptostyp
 }
 
 func (c *RestClient) SubmitTransaction(transaction *aptostypes.Transaction) (res *aptostypes.Transaction, err error) {
+	return c.SubmitTransactionWithContext(context.Background(), transaction)
+}
+
+func (c *RestClient) SubmitTransactionWithContext(ctx context.Context, transaction *aptostypes.Transaction) (res *aptostypes.Transaction, err error) {
 	data, err := json.Marshal(transaction)
 	if err != nil {
 		return
 	}
-	req, err := http.NewRequest("POST", c.GetVersionedRpcUrl()+"/transactions", bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.GetVersionedRpcUrl()+"/transactions", bytes.NewReader(data))
 	if err != nil {
 		return
 	}
@@ -145,11 +202,15 @@ func (c *RestClient) SubmitTransaction(transaction *aptostypes.Transaction) (res
 }
 
 func (c *RestClient) CreateTransactionSigningMessage(transaction *aptostypes.Transaction) (message []byte, err error) {
+	return c.CreateTransactionSigningMessageWithContext(context.Background(), transaction)
+}
+
+func (c *RestClient) CreateTransactionSigningMessageWithContext(ctx context.Context, transaction *aptostypes.Transaction) (message []byte, err error) {
 	data, err := json.Marshal(transaction)
 	if err != nil {
 		return
 	}
-	req, err := http.NewRequest("POST", c.GetVersionedRpcUrl()+"/transactions/encode_submission", bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.GetVersionedRpcUrl()+"/transactions/encode_submission", bytes.NewReader(data))
 	if err != nil {
 		return
 	}
@@ -168,18 +229,30 @@ func (c *RestClient) CreateTransactionSigningMessage(transaction *aptostypes.Tra
 }
 
 func (c *RestClient) EstimateGasPrice() (price uint64, err error) {
-	req, err := http.NewRequest("GET", c.GetVersionedRpcUrl()+"/estimate_gas_price", nil)
+	return c.EstimateGasPriceWithContext(context.Background())
+}
+
+func (c *RestClient) EstimateGasPriceWithContext(ctx context.Context) (price uint64, err error) {
+	res, err := c.EstimateGasPricesWithContext(ctx)
 	if err != nil {
 		return
 	}
-	req.Header["Content-Type"] = []string{"application/json"}
+	return res.GasEstimate, nil
+}
 
-	res := struct {
-		GasEstimate uint64 `json:"gas_estimate"`
-	}{}
-	err = c.doReq(req, &res)
+// EstimateGasPrices returns the gas unit price estimate, with the deprioritized and prioritized estimates
+func (c *RestClient) EstimateGasPrices() (res *aptostypes.GasEstimation, err error) {
+	return c.EstimateGasPricesWithContext(context.Background())
+}
+
+func (c *RestClient) EstimateGasPricesWithContext(ctx context.Context) (res *aptostypes.GasEstimation, err error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.GetVersionedRpcUrl()+"/estimate_gas_price", nil)
 	if err != nil {
 		return
 	}
-	return res.GasEstimate, nil
+	req.Header["Content-Type"] = []string{"application/json"}
+
+	res = &aptostypes.GasEstimation{}
+	err = c.doReq(req, res)
+	return
 }
@@ -0,0 +1,61 @@
+package aptosclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ViewRequest struct {
+	Function      string   `json:"function"`
+	TypeArguments []string `json:"type_arguments"`
+	Arguments     []any    `json:"arguments"`
+}
+
+/**
+ * Execute a view function (a public function with the `#[view]` attribute) and get its return values.
+ *
+ * The arguments are sent as JSON, so u64, u128 and u256 values should be decimal strings,
+ * addresses and vector<u8> should be hex strings. Use `TransactionBuilderRemoteABI.View`
+ * in transaction_builder to validate and encode arguments and decode the return values
+ * according to the function's ABI.
+ * @param out the return values of the function will be called json.Unmarshal, usually a pointer of slice
+ * @param function The function id, e.g. `0x1::coin::balance`
+ * @param typeArgs Type arguments of the function
+ * @param args Arguments of the function
+ * @param ledgerVersion Ledger version to execute the function, If not provided, it will be the latest version
+ */
+func (c *RestClient) View(out interface{}, function string, typeArgs []string, args []any, ledgerVersion string) (err error) {
+	return c.ViewWithContext(context.Background(), out, function, typeArgs, args, ledgerVersion)
+}
+
+func (c *RestClient) ViewWithContext(ctx context.Context, out interface{}, function string, typeArgs []string, args []any, ledgerVersion string) (err error) {
+	url := fmt.Sprintf("%v/view", c.GetVersionedRpcUrl())
+	if ledgerVersion != "" {
+		url = fmt.Sprintf("%v?ledger_version=%v", url, ledgerVersion)
+	}
+	if typeArgs == nil {
+		typeArgs = []string{}
+	}
+	if args == nil {
+		args = []any{}
+	}
+	bodyData, err := json.Marshal(ViewRequest{
+		Function:      function,
+		TypeArguments: typeArgs,
+		Arguments:     args,
+	})
+	if err != nil {
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(bodyData))
+	if err != nil {
+		return
+	}
+	req.Header["Content-Type"] = []string{"application/json"}
+
+	err = c.doReq(req, out)
+	return
+}
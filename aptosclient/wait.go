@@ -0,0 +1,145 @@
+package aptosclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+)
+
+const (
+	DefaultWaitTimeout         = 20 * time.Second
+	DefaultWaitPollInterval    = 500 * time.Millisecond
+	DefaultWaitMaxPollInterval = 5 * time.Second
+	DefaultWaitBackoff         = 1.5
+)
+
+var ErrWaitTimeout = errors.New("Wait for transaction timeout.")
+
+// TransactionFailedError is returned by `WaitForTransaction` when `CheckSuccess` is enabled and
+// the committed transaction was not executed successfully.
+type TransactionFailedError struct {
+	Transaction *aptostypes.Transaction
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("Transaction %v failed: %v", e.Transaction.Hash, e.Transaction.VmStatus)
+}
+
+type WaitOptions struct {
+	// The maximum duration to wait, default is `DefaultWaitTimeout`.
+	Timeout time.Duration
+	// The interval before the first retry, default is `DefaultWaitPollInterval`.
+	PollInterval time.Duration
+	// The upper limit of the interval after backoff, default is `DefaultWaitMaxPollInterval`.
+	MaxPollInterval time.Duration
+	// The interval will be multiplied by Backoff after each poll, default is `DefaultWaitBackoff`.
+	// Set 1 to poll with a fixed interval.
+	Backoff float64
+	// If true, a committed transaction whose `Success` is false will return `*TransactionFailedError`.
+	CheckSuccess bool
+}
+
+func (o *WaitOptions) withDefaults() WaitOptions {
+	opts := WaitOptions{}
+	if o != nil {
+		opts = *o
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultWaitTimeout
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultWaitPollInterval
+	}
+	if opts.MaxPollInterval <= 0 {
+		opts.MaxPollInterval = DefaultWaitMaxPollInterval
+	}
+	if opts.MaxPollInterval < opts.PollInterval {
+		opts.MaxPollInterval = opts.PollInterval
+	}
+	if opts.Backoff < 1 {
+		opts.Backoff = DefaultWaitBackoff
+	}
+	return opts
+}
+
+/**
+ * Waits for a transaction to move past pending state.
+ * A transaction that is not found (error with code 404) or still of type `pending_transaction` is considered pending.
+ * @param txHash The hash of the transaction
+ * @param opts Optional configuration, nil to use the defaults
+ * @returns The committed transaction, or `ErrWaitTimeout` if it is still pending after the timeout.
+ */
+func (c *RestClient) WaitForTransaction(txHash string, opts *WaitOptions) (*aptostypes.Transaction, error) {
+	return c.WaitForTransactionWithContext(context.Background(), txHash, opts)
+}
+
+func (c *RestClient) WaitForTransactionWithContext(ctx context.Context, txHash string, opts *WaitOptions) (*aptostypes.Transaction, error) {
+	options := opts.withDefaults()
+	// the timeout also bounds the requests, so a hanging request won't exceed it
+	waitCtx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+	// waitErr returns `ErrWaitTimeout` if the timeout is reached, or the error of the parent context
+	waitErr := func() error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return ErrWaitTimeout
+	}
+
+	interval := options.PollInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		txn, err := c.GetTransactionByHashWithContext(waitCtx, txHash)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return nil, waitErr()
+			}
+			if restErr, ok := err.(*aptostypes.RestError); !ok || restErr.Code != 404 {
+				return nil, err
+			}
+		} else if txn.Type != aptostypes.TypePendingTransaction {
+			if options.CheckSuccess && !txn.Success {
+				return txn, &TransactionFailedError{Transaction: txn}
+			}
+			return txn, nil
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(interval)
+		select {
+		case <-waitCtx.Done():
+			return nil, waitErr()
+		case <-timer.C:
+		}
+		interval = time.Duration(float64(interval) * options.Backoff)
+		if interval > options.MaxPollInterval {
+			interval = options.MaxPollInterval
+		}
+	}
+}
+
+/**
+ * Submits a signed BCS transaction and waits for it to be committed.
+ * @param signedTxn A BCS transaction representation
+ * @param opts Optional configuration of waiting, nil to use the defaults
+ */
+func (c *RestClient) SubmitAndWait(signedTxn []byte, opts *WaitOptions) (*aptostypes.Transaction, error) {
+	return c.SubmitAndWaitWithContext(context.Background(), signedTxn, opts)
+}
+
+func (c *RestClient) SubmitAndWaitWithContext(ctx context.Context, signedTxn []byte, opts *WaitOptions) (*aptostypes.Transaction, error) {
+	pending, err := c.SubmitSignedBCSTransactionWithContext(ctx, signedTxn)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForTransactionWithContext(ctx, pending.Hash, opts)
+}
@@ -0,0 +1,65 @@
+package aptosclient
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForTransaction(t *testing.T) {
+	var polls int32
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&polls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Transaction not found","error_code":"transaction_not_found"}`))
+		case 2:
+			w.Write([]byte(`{"type":"pending_transaction","hash":"0x1234"}`))
+		default:
+			w.Write([]byte(`{"type":"user_transaction","hash":"0x1234","version":"101","success":false,"vm_status":"Move abort"}`))
+		}
+	})
+	opts := &WaitOptions{PollInterval: time.Millisecond}
+
+	txn, err := client.WaitForTransaction("0x1234", opts)
+	require.Nil(t, err)
+	require.Equal(t, uint64(101), txn.Version)
+	require.Equal(t, int32(3), polls)
+
+	opts.CheckSuccess = true
+	txn, err = client.WaitForTransaction("0x1234", opts)
+	require.IsType(t, &TransactionFailedError{}, err)
+	require.Equal(t, "Move abort", txn.VmStatus)
+}
+
+func TestWaitForTransactionTimeout(t *testing.T) {
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"pending_transaction","hash":"0x1234"}`))
+	})
+	_, err := client.WaitForTransaction("0x1234", &WaitOptions{
+		Timeout:      20 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+	require.Equal(t, ErrWaitTimeout, err)
+}
+
+func TestWaitForTransactionTimeoutHangingRequest(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	client := MockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	start := time.Now()
+	_, err := client.WaitForTransaction("0x1234", &WaitOptions{
+		Timeout:      20 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+	require.Equal(t, ErrWaitTimeout, err)
+	require.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,37 @@
+package aptostypes
+
+// The BCS layouts of some framework resources, they can be decoded from the BCS responses of the rest api.
+
+// AccountResourceBCS is the BCS layout of resource `0x1::account::Account`
+type AccountResourceBCS struct {
+	AuthenticationKey       []byte             `lcs:"authentication_key"`
+	SequenceNumber          uint64             `lcs:"sequence_number"`
+	GuidCreationNum         uint64             `lcs:"guid_creation_num"`
+	CoinRegisterEvents      EventHandleBCS     `lcs:"coin_register_events"`
+	KeyRotationEvents       EventHandleBCS     `lcs:"key_rotation_events"`
+	RotationCapabilityOffer CapabilityOfferBCS `lcs:"rotation_capability_offer"`
+	SignerCapabilityOffer   CapabilityOfferBCS `lcs:"signer_capability_offer"`
+}
+
+type GuidBCS struct {
+	CreationNumber uint64   `lcs:"creation_num"`
+	AccountAddress [32]byte `lcs:"addr"`
+}
+
+type EventHandleBCS struct {
+	Counter uint64  `lcs:"counter"`
+	Guid    GuidBCS `lcs:"guid"`
+}
+
+// CapabilityOfferBCS is the layout of `0x1::account::CapabilityOffer<T>`, the `For` is a Move `Option<address>`
+type CapabilityOfferBCS struct {
+	For [][32]byte `lcs:"for"`
+}
+
+// CoinStoreBCS is the BCS layout of resource `0x1::coin::CoinStore<T>`
+type CoinStoreBCS struct {
+	Coin           uint64         `lcs:"coin"`
+	Frozen         bool           `lcs:"frozen"`
+	DepositEvents  EventHandleBCS `lcs:"deposit_events"`
+	WithdrawEvents EventHandleBCS `lcs:"withdraw_events"`
+}
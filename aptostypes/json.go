@@ -69,3 +69,34 @@ func errNonString(typ reflect.Type) error {
 func toUnmarshalTypeError(err error, typ reflect.Type) error {
 	return &json.UnmarshalTypeError{Value: err.Error(), Type: typ}
 }
+
+// U64 represents a Move u64 value in the rest api, which is a JSON string, e.g. "1000"
+type U64 uint64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *U64) UnmarshalJSON(input []byte) error {
+	return (*jsonUint64)(u).UnmarshalJSON(input)
+}
+
+func (u U64) MarshalJSON() ([]byte, error) {
+	return jsonUint64(u).MarshalJSON()
+}
+
+// U128 represents a Move u128 or u256 value in the rest api, which is a JSON string
+type U128 big.Int
+
+// U256 has the same JSON representation as U128
+type U256 = U128
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *U128) UnmarshalJSON(input []byte) error {
+	return (*jsonBig)(u).UnmarshalJSON(input)
+}
+
+func (u *U128) MarshalJSON() ([]byte, error) {
+	return (*jsonBig)(u).MarshalJSON()
+}
+
+func (u *U128) BigInt() *big.Int {
+	return (*big.Int)(u)
+}
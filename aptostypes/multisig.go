@@ -0,0 +1,74 @@
+package aptostypes
+
+import "sort"
+
+// The JSON representations of `0x1::multisig_account` resources in the rest api.
+
+// MoveOption is the JSON of Move `0x1::option::Option<T>`
+type MoveOption[T any] struct {
+	Vec []T `json:"vec"`
+}
+
+// Value returns the value and true if the option is some
+func (o MoveOption[T]) Value() (T, bool) {
+	if len(o.Vec) == 0 {
+		var zero T
+		return zero, false
+	}
+	return o.Vec[0], true
+}
+
+type SimpleMapEntry[K any, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// SimpleMap is the JSON of Move `0x1::simple_map::SimpleMap<K, V>`
+type SimpleMap[K any, V any] struct {
+	Data []SimpleMapEntry[K, V] `json:"data"`
+}
+
+type TableHandle struct {
+	Handle string `json:"handle"`
+}
+
+// MultisigAccount is the resource `0x1::multisig_account::MultisigAccount`
+type MultisigAccount struct {
+	Owners                     []string                  `json:"owners"`
+	NumSignaturesRequired      U64                       `json:"num_signatures_required"`
+	Metadata                   SimpleMap[string, string] `json:"metadata"`
+	Transactions               TableHandle               `json:"transactions"`
+	LastExecutedSequenceNumber U64                       `json:"last_executed_sequence_number"`
+	NextSequenceNumber         U64                       `json:"next_sequence_number"`
+}
+
+// MultisigTransaction is the `0x1::multisig_account::MultisigTransaction` in the table `MultisigAccount.Transactions`
+type MultisigTransaction struct {
+	// The hex of the BCS bytes of `MultisigTransactionPayload`, it's none if only the hash is stored.
+	Payload          MoveOption[string]      `json:"payload"`
+	PayloadHash      MoveOption[string]      `json:"payload_hash"`
+	Votes            SimpleMap[string, bool] `json:"votes"`
+	Creator          string                  `json:"creator"`
+	CreationTimeSecs U64                     `json:"creation_time_secs"`
+}
+
+// Approvals returns the sorted owners who approved the transaction
+func (t *MultisigTransaction) Approvals() []string {
+	return t.voters(true)
+}
+
+// Rejections returns the sorted owners who rejected the transaction
+func (t *MultisigTransaction) Rejections() []string {
+	return t.voters(false)
+}
+
+func (t *MultisigTransaction) voters(approved bool) []string {
+	res := []string{}
+	for _, vote := range t.Votes.Data {
+		if vote.Value == approved {
+			res = append(res, vote.Key)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -176,6 +176,7 @@ type MoveFunction struct {
 	Name              string        `json:"name"`
 	Visibility        string        `json:"visibility"` // public|script|friend
 	IsEntry           bool          `json:"is_entry"`
+	IsView            bool          `json:"is_view"`
 	GenericTypeParams []interface{} `json:"generic_type_params"`
 	Params            []string      `json:"params"`
 	Return            []string      `json:"return"`
@@ -254,6 +255,12 @@ type AccountResource struct {
 	Data map[string]interface{} `json:"data"`
 }
 
+type GasEstimation struct {
+	DeprioritizedGasEstimate uint64 `json:"deprioritized_gas_estimate"`
+	GasEstimate              uint64 `json:"gas_estimate"`
+	PrioritizedGasEstimate   uint64 `json:"prioritized_gas_estimate"`
+}
+
 type CoinInfo struct {
 	Decimals int
 	Symbol   string
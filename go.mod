@@ -4,6 +4,7 @@ go 1.18
 
 require (
 	github.com/coming-chat/lcs v0.0.0-20220829063658-0fa8432d2bdf
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.2.0
 	github.com/stretchr/testify v1.8.0
 	github.com/tyler-smith/go-bip39 v1.1.0
 	golang.org/x/crypto v0.17.0
@@ -1,6 +1,7 @@
 package nft
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -254,25 +255,15 @@ func (c *TokenClient) GetAllTokenForAccount(account txnBuilder.AccountAddress) (
 	}
 
 	const limit = 200
-	offset := uint64(0)
-	for {
-		txns, err := c.GetAccountTransactions(owner, offset, limit)
+	it := c.IterateAccountTransactions(context.Background(), owner, 0, 0, limit)
+	for it.Next() {
+		err := parseNftFromTransaction(it.Value())
 		if err != nil {
 			return nil, err
 		}
-
-		for _, txn := range txns {
-			err = parseNftFromTransaction(txn)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if len(txns) < limit {
-			break
-		} else {
-			offset = offset + limit
-		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
 	}
 
 	nfts := []*NFTInfo{}
@@ -9,6 +9,8 @@ func init() {
 		TransactionAuthenticatorEd25519{},
 		TransactionAuthenticatorMultiEd25519{},
 		TransactionAuthenticatorMultiAgent{},
+		TransactionAuthenticatorFeePayer{},
+		TransactionAuthenticatorSingleSender{},
 	)
 
 	lcs.RegisterEnum(
@@ -16,6 +18,8 @@ func init() {
 
 		AccountAuthenticatorEd25519{},
 		AccountAuthenticatorMultiEd25519{},
+		AccountAuthenticatorSingleKey{},
+		AccountAuthenticatorMultiKey{},
 	)
 }
 
@@ -39,6 +43,19 @@ type TransactionAuthenticatorMultiAgent struct {
 	SecondarySigners         []AccountAuthenticator `lcs:"secondary_signers"`
 }
 
+type TransactionAuthenticatorFeePayer struct {
+	Sender                   AccountAuthenticator   `lcs:"sender"`
+	SecondarySignerAddresses []AccountAddress       `lcs:"secondary_signer_addresses"`
+	SecondarySigners         []AccountAuthenticator `lcs:"secondary_signers"`
+	FeePayerAddress          AccountAddress         `lcs:"fee_payer_address"`
+	FeePayerSigner           AccountAuthenticator   `lcs:"fee_payer_signer"`
+}
+
+// TransactionAuthenticatorSingleSender is the authenticator of the sender whose account isn't Ed25519 or MultiEd25519, e.g. `SingleKey` and `MultiKey`
+type TransactionAuthenticatorSingleSender struct {
+	Sender AccountAuthenticator `lcs:"sender"`
+}
+
 // ------ AccountAuthenticator ------
 
 type AccountAuthenticator interface{}
@@ -52,3 +69,13 @@ type AccountAuthenticatorMultiEd25519 struct {
 	PublicKey MultiEd25519PublicKey `lcs:"publicKey"`
 	Signature MultiEd25519Signature `lcs:"signature"`
 }
+
+type AccountAuthenticatorSingleKey struct {
+	PublicKey AnyPublicKey `lcs:"public_key"`
+	Signature AnySignature `lcs:"signature"`
+}
+
+type AccountAuthenticatorMultiKey struct {
+	PublicKey MultiKey          `lcs:"public_keys"`
+	Signature MultiKeySignature `lcs:"signature"`
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/coming-chat/lcs"
 	"golang.org/x/crypto/sha3"
@@ -12,6 +13,7 @@ import (
 const (
 	RAW_TRANSACTION_SALT           = "APTOS::RawTransaction"
 	RAW_TRANSACTION_WITH_DATA_SALT = "APTOS::RawTransactionWithData"
+	TRANSACTION_SALT               = "APTOS::Transaction"
 )
 
 type SigningMessage []byte
@@ -29,9 +31,22 @@ func (t *RawTransaction) GetSigningMessage() (SigningMessage, error) {
 	return append(prefixBytes[:], msg...), nil
 }
 
+// The signing message of fee payer transaction, it's the BCS of `RawTransactionWithData` enum with the salt
+func (t *FeePayerRawTransaction) GetSigningMessage() (SigningMessage, error) {
+	prefixBytes := sha3.Sum256([]byte(RAW_TRANSACTION_WITH_DATA_SALT))
+	var txnWithData RawTransactionWithData = *t
+	msg, err := lcs.Marshal(&txnWithData)
+	if err != nil {
+		return nil, err
+	}
+	return append(prefixBytes[:], msg...), nil
+}
+
+// The signing message of multi-agent transaction, it's the BCS of `RawTransactionWithData` enum with the salt
 func (t *MultiAgentRawTransaction) GetSigningMessage() (SigningMessage, error) {
 	prefixBytes := sha3.Sum256([]byte(RAW_TRANSACTION_WITH_DATA_SALT))
-	msg, err := lcs.Marshal(t)
+	var txnWithData RawTransactionWithData = *t
+	msg, err := lcs.Marshal(&txnWithData)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +98,34 @@ func (b *TransactionBuilderEd25519) Sign(rawTxn *RawTransaction) (data []byte, e
 	return data, err
 }
 
+// SignAccountAuthenticator signs the message, e.g. `FeePayerRawTransaction`, and returns the authenticator of the account
+func (b *TransactionBuilderEd25519) SignAccountAuthenticator(signable Signable) (AccountAuthenticator, error) {
+	if b.SigningFn == nil {
+		return nil, errors.New("Signing failed: you must specify a signing function")
+	}
+	signingMessage, err := signable.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	publickey, err := NewEd25519PublicKey(b.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := NewEd25519Signature(b.SigningFn(signingMessage))
+	if err != nil {
+		return nil, err
+	}
+	return AccountAuthenticatorEd25519{
+		PublicKey: *publickey,
+		Signature: *signature,
+	}, nil
+}
+
+// SignForSimulation signs the transaction with an invalid signature, which can only be used to simulate the transaction
+func (b *TransactionBuilderEd25519) SignForSimulation(rawTxn *RawTransaction) (data []byte, err error) {
+	return GenerateBCSSimulation(b.PublicKey, rawTxn)
+}
+
 // ------ TransactionBuilderMultiEd25519 ------
 
 type SigningFunctionMultiEd25519 func(SigningMessage) MultiEd25519Signature
@@ -114,6 +157,40 @@ func (b *TransactionBuilderMultiEd25519) Sign(rawTxn *RawTransaction) (data []by
 	return data, err
 }
 
+// SignAccountAuthenticator signs the message, e.g. `FeePayerRawTransaction`, and returns the authenticator of the account
+func (b *TransactionBuilderMultiEd25519) SignAccountAuthenticator(signable Signable) (AccountAuthenticator, error) {
+	if b.SigningFn == nil {
+		return nil, errors.New("Signing failed: you must specify a signing function")
+	}
+	signingMessage, err := signable.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	return AccountAuthenticatorMultiEd25519{
+		PublicKey: b.PublicKey,
+		Signature: b.SigningFn(signingMessage),
+	}, nil
+}
+
+// SignForSimulation signs the transaction with `threshold` invalid signatures, which can only be used to simulate the transaction
+func (b *TransactionBuilderMultiEd25519) SignForSimulation(rawTxn *RawTransaction) (data []byte, err error) {
+	signatures := [][]byte{}
+	bits := []uint8{}
+	for i := uint8(0); i < b.PublicKey.Threshold; i++ {
+		signatures = append(signatures, make([]byte, ED25519_SIGNATURE_LENGTH))
+		bits = append(bits, i)
+	}
+	signature, err := NewMultiEd25519Signature(signatures, bits)
+	if err != nil {
+		return
+	}
+	builder := TransactionBuilderMultiEd25519{
+		SigningFn: func(sm SigningMessage) MultiEd25519Signature { return *signature },
+		PublicKey: b.PublicKey,
+	}
+	return builder.Sign(rawTxn)
+}
+
 // ------ TransactionBuilderABI ------
 
 type ABIBuilderConfig struct {
@@ -126,8 +203,8 @@ type ABIBuilderConfig struct {
 }
 
 type TransactionBuilderABI struct {
-	ABIMap map[string]ScriptABI
-	// BuildereConfig ABIBuilderConfig
+	ABIMap        map[string]ScriptABI
+	BuilderConfig ABIBuilderConfig
 }
 
 func NewTransactionBuilderABI(abis [][]byte) (*TransactionBuilderABI, error) {
@@ -212,6 +289,33 @@ func (tb *TransactionBuilderABI) BuildTransactionPayload(function string, tyTags
 	return TransactionPayload(payload), nil
 }
 
+/**
+ * Builds a RawTransaction with the payload and `BuilderConfig`
+ * @param function Entry function or script name
+ * @param tyTags TypeTag strings
+ * @param args Function arguments
+ */
+func (tb *TransactionBuilderABI) Build(function string, tyTags []string, args []any) (*RawTransaction, error) {
+	payload, err := tb.BuildTransactionPayload(function, tyTags, args)
+	if err != nil {
+		return nil, err
+	}
+	return tb.BuilderConfig.BuildRawTransaction(payload), nil
+}
+
+// BuildRawTransaction builds a RawTransaction with the payload, the expiration is `ExpSecFromNow` seconds after now.
+func (c ABIBuilderConfig) BuildRawTransaction(payload TransactionPayload) *RawTransaction {
+	return &RawTransaction{
+		Sender:                  c.Sender,
+		SequenceNumber:          c.SequenceNumber,
+		Payload:                 payload,
+		MaxGasAmount:            c.MaxGasAmount,
+		GasUnitPrice:            c.GasUnitPrice,
+		ExpirationTimestampSecs: uint64(time.Now().Unix()) + c.ExpSecFromNow,
+		ChainId:                 c.ChainId,
+	}
+}
+
 func toBCSArgs(abiArgs []ArgumentABI, args []any) ([][]byte, error) {
 	if len(abiArgs) != len(args) {
 		return nil, errors.New("Wrong number of args provided.")
@@ -3,6 +3,7 @@ package transactionbuilder
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"strconv"
@@ -73,6 +74,8 @@ func tokenize(tagStr string) ([]Token, error) {
 
 type TypeTagParser struct {
 	Tokens []Token
+	// Used to replace generic type params `T0`, `T1`... if it's not nil.
+	TypeArgs []TypeTag
 }
 
 var errInvalidTypeTag = errors.New("Invalid type tag.")
@@ -85,6 +88,27 @@ func NewTypeTagParser(tyArg string) (*TypeTagParser, error) {
 	return &TypeTagParser{Tokens: tokens}, nil
 }
 
+// NewTypeTagParserWithTypeArgs creates a parser that replaces the generic type params `T0`, `T1`... with typeArgs
+func NewTypeTagParserWithTypeArgs(tyArg string, typeArgs []string) (*TypeTagParser, error) {
+	parser, err := NewTypeTagParser(tyArg)
+	if err != nil {
+		return nil, err
+	}
+	parser.TypeArgs = []TypeTag{}
+	for _, typeArg := range typeArgs {
+		argParser, err := NewTypeTagParser(typeArg)
+		if err != nil {
+			return nil, err
+		}
+		tag, err := argParser.ParseTypeTag()
+		if err != nil {
+			return nil, err
+		}
+		parser.TypeArgs = append(parser.TypeArgs, tag)
+	}
+	return parser, nil
+}
+
 func (p *TypeTagParser) shift() *Token {
 	if len(p.Tokens) == 0 {
 		return nil
@@ -144,14 +168,22 @@ func (p *TypeTagParser) ParseTypeTag() (TypeTag, error) {
 	switch token.Value {
 	case "u8":
 		return TypeTagU8{}, nil
+	case "u16":
+		return TypeTagU16{}, nil
+	case "u32":
+		return TypeTagU32{}, nil
 	case "u64":
 		return TypeTagU64{}, nil
 	case "u128":
 		return TypeTagU128{}, nil
+	case "u256":
+		return TypeTagU256{}, nil
 	case "bool":
 		return TypeTagBool{}, nil
 	case "address":
 		return TypeTagAddress{}, nil
+	case "signer":
+		return TypeTagSigner{}, nil
 	case "vector":
 		err = p.consume("<")
 		if err != nil {
@@ -167,6 +199,14 @@ func (p *TypeTagParser) ParseTypeTag() (TypeTag, error) {
 		}
 		return TypeTagVector{Value: tag}, nil
 	}
+	if p.TypeArgs != nil && token.Type == "IDENT" && strings.HasPrefix(token.Value, "T") {
+		if idx, err := strconv.ParseUint(token.Value[1:], 10, 64); err == nil {
+			if idx >= uint64(len(p.TypeArgs)) {
+				return nil, fmt.Errorf("Missing type argument for %v.", token.Value)
+			}
+			return p.TypeArgs[idx], nil
+		}
+	}
 	if token.Type == "IDENT" && (strings.HasPrefix(token.Value, "0x") || strings.HasPrefix(token.Value, "0X")) {
 		address := token.Value
 
@@ -242,6 +282,40 @@ func serializeArg(argVal any, argType TypeTag, encoder *lcs.Encoder) error {
 			}
 			return encoder.Encode(uint8(u))
 		}
+	case TypeTagU16:
+		if v, ok := argVal.(uint16); ok {
+			return encoder.Encode(v)
+		}
+		if v, ok := argVal.(int); ok && v == int(uint16(v)) {
+			return encoder.Encode(uint16(v))
+		}
+		if v, ok := argVal.(float64); ok && v == float64(uint16(v)) {
+			return encoder.Encode(uint16(v))
+		}
+		if v, ok := argVal.(string); ok {
+			u, err := strconv.ParseUint(v, 10, 16)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(uint16(u))
+		}
+	case TypeTagU32:
+		if v, ok := argVal.(uint32); ok {
+			return encoder.Encode(v)
+		}
+		if v, ok := argVal.(int); ok && v == int(uint32(v)) {
+			return encoder.Encode(uint32(v))
+		}
+		if v, ok := argVal.(float64); ok && v == float64(uint32(v)) {
+			return encoder.Encode(uint32(v))
+		}
+		if v, ok := argVal.(string); ok {
+			u, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(uint32(u))
+		}
 	case TypeTagU64:
 		if v, ok := argVal.(uint64); ok {
 			return encoder.Encode(v)
@@ -267,16 +341,42 @@ func serializeArg(argVal any, argType TypeTag, encoder *lcs.Encoder) error {
 			return encoder.Encode(Uint128{v})
 		}
 		if v, ok := argVal.(int); ok && v >= 0 {
-			return encoder.Encode(Uint128{big.NewInt(int64(v))})
+			return encoder.Encode(Uint128{big.NewInt(0).SetUint64(uint64(v))})
 		}
-		if v, ok := argVal.(float64); ok && v >= 0 {
-			return encoder.Encode(Uint128{big.NewInt(int64(v))})
+		if v, ok := argVal.(float64); ok {
+			i, err := bigIntFromFloat64(v)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(Uint128{i})
 		}
 		if v, ok := argVal.(string); ok {
 			if big, ok := big.NewInt(0).SetString(v, 10); ok {
 				return encoder.Encode(Uint128{big})
 			}
 		}
+	case TypeTagU256:
+		if v, ok := argVal.(Uint256); ok {
+			return encoder.Encode(v)
+		}
+		if v, ok := argVal.(*big.Int); ok {
+			return encoder.Encode(Uint256{v})
+		}
+		if v, ok := argVal.(int); ok && v >= 0 {
+			return encoder.Encode(Uint256{big.NewInt(0).SetUint64(uint64(v))})
+		}
+		if v, ok := argVal.(float64); ok {
+			i, err := bigIntFromFloat64(v)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(Uint256{i})
+		}
+		if v, ok := argVal.(string); ok {
+			if big, ok := big.NewInt(0).SetString(v, 10); ok {
+				return encoder.Encode(Uint256{big})
+			}
+		}
 	case TypeTagAddress:
 		if v, ok := argVal.(AccountAddress); ok {
 			return encoder.Encode(v)
@@ -307,7 +407,7 @@ func serializeArg(argVal any, argType TypeTag, encoder *lcs.Encoder) error {
 			return errors.New("Invalid vector args.")
 		}
 		length := rv.Len()
-		if err := encoder.EncodeUleb128(uint64(length)); err != nil {
+		if err := encodeLength(encoder, length); err != nil {
 			return err
 		}
 		for i := 0; i < length; i++ {
@@ -318,11 +418,29 @@ func serializeArg(argVal any, argType TypeTag, encoder *lcs.Encoder) error {
 		return nil
 	case TypeTagStruct:
 		tag := argType.(TypeTagStruct)
-		if tag.ShortFunctionName() != "0x1::string::String" {
-			return errors.New("The only supported struct arg is of type 0x1::string::String")
-		}
-		if v, ok := argVal.(string); ok {
-			return encoder.Encode(v)
+		switch tag.ShortFunctionName() {
+		case "0x1::string::String":
+			if v, ok := argVal.(string); ok {
+				return encoder.Encode(v)
+			}
+		case "0x1::option::Option":
+			return serializeOptionArg(argVal, tag, encoder)
+		case "0x1::object::Object":
+			return serializeArg(argVal, TypeTagAddress{}, encoder)
+		case "0x1::fixed_point32::FixedPoint32":
+			raw, err := fixedPointRawValue(argVal, 32)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(raw.Uint64())
+		case "0x1::fixed_point64::FixedPoint64":
+			raw, err := fixedPointRawValue(argVal, 64)
+			if err != nil {
+				return err
+			}
+			return encoder.Encode(Uint128{raw})
+		default:
+			return fmt.Errorf("Unsupported struct arg type %v.", tag)
 		}
 	default:
 		return errors.New("Unsupported arg type.")
@@ -330,6 +448,104 @@ func serializeArg(argVal any, argType TypeTag, encoder *lcs.Encoder) error {
 	return fmt.Errorf("Invalid argument %v.", argVal)
 }
 
+// bigIntFromFloat64 converts the float64 (e.g. the number decoded from JSON) to the exact integer,
+// the negative or non-integer number is rejected.
+func bigIntFromFloat64(v float64) (*big.Int, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		return nil, fmt.Errorf("Invalid argument %v: not an unsigned integer.", v)
+	}
+	f := big.NewFloat(0).SetFloat64(v)
+	if !f.IsInt() {
+		return nil, fmt.Errorf("Invalid argument %v: not an unsigned integer.", v)
+	}
+	i, _ := f.Int(nil)
+	return i, nil
+}
+
+// serializeOptionArg encodes `0x1::option::Option<T>` as a vector of zero or one element,
+// the argVal nil (or nil pointer, including nil *big.Int and *big.Rat) is none, a pointer or any other value is some.
+func serializeOptionArg(argVal any, tag TypeTagStruct, encoder *lcs.Encoder) error {
+	if len(tag.TypeArgs) != 1 {
+		return errors.New("Invalid option type.")
+	}
+	if argVal == nil {
+		return encodeLength(encoder, 0)
+	}
+	switch v := argVal.(type) {
+	case *big.Int:
+		// It's the value of u128 and u256, not the pointer of option.
+		if v == nil {
+			return encodeLength(encoder, 0)
+		}
+	case *big.Rat:
+		// It's the value of fixed point, not the pointer of option.
+		if v == nil {
+			return encodeLength(encoder, 0)
+		}
+	default:
+		rv := reflect.ValueOf(argVal)
+		if rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return encodeLength(encoder, 0)
+			}
+			argVal = rv.Elem().Interface()
+		}
+	}
+	if err := encodeLength(encoder, 1); err != nil {
+		return err
+	}
+	return serializeArg(argVal, tag.TypeArgs[0], encoder)
+}
+
+// encodeLength encodes the uleb128 length and flushes it, the encoder is flushed only by `Encode`.
+func encodeLength(encoder *lcs.Encoder, length int) error {
+	if err := encoder.EncodeUleb128(uint64(length)); err != nil {
+		return err
+	}
+	return encoder.Encode(struct{}{})
+}
+
+// fixedPointRawValue returns the raw value of fixed point number with the fractional bits,
+// the precision beyond the fractional bits is truncated.
+func fixedPointRawValue(argVal any, fractionalBits uint) (*big.Int, error) {
+	var value *big.Rat
+	switch v := argVal.(type) {
+	case *big.Rat:
+		value = v
+	case big.Rat:
+		value = &v
+	case string:
+		r, ok := big.NewRat(0, 1).SetString(v)
+		if !ok {
+			return nil, fmt.Errorf("Invalid fixed point number %v.", v)
+		}
+		value = r
+	case int:
+		value = big.NewRat(int64(v), 1)
+	case uint64:
+		value = big.NewRat(0, 1).SetInt(big.NewInt(0).SetUint64(v))
+	case float64:
+		value = big.NewRat(0, 1).SetFloat64(v)
+	}
+	if value == nil {
+		return nil, fmt.Errorf("Invalid argument %v.", argVal)
+	}
+	if value.Sign() < 0 {
+		return nil, fmt.Errorf("Invalid fixed point number %v: negative number.", value.RatString())
+	}
+	scaled := big.NewRat(0, 1).Mul(value, big.NewRat(0, 1).SetInt(big.NewInt(0).Lsh(big.NewInt(1), fractionalBits)))
+	raw := big.NewInt(0).Quo(scaled.Num(), scaled.Denom())
+	if raw.BitLen() > int(fractionalBits)*2 {
+		return nil, fmt.Errorf("Invalid fixed point number %v: too large number.", value.RatString())
+	}
+	return raw, nil
+}
+
+// fixedPointFromRawValue is the reverse of `fixedPointRawValue`
+func fixedPointFromRawValue(raw *big.Int, fractionalBits uint) *big.Rat {
+	return big.NewRat(0, 1).SetFrac(raw, big.NewInt(0).Lsh(big.NewInt(1), fractionalBits))
+}
+
 func argToTransactionArgument(argVal any, argType TypeTag) (TransactionArgument, error) {
 	switch argType.(type) {
 	case TypeTagBool:
@@ -340,6 +556,14 @@ func argToTransactionArgument(argVal any, argType TypeTag) (TransactionArgument,
 		if v, ok := argVal.(uint8); ok {
 			return TransactionArgumentU8{v}, nil
 		}
+	case TypeTagU16:
+		if v, ok := argVal.(uint16); ok {
+			return TransactionArgumentU16{v}, nil
+		}
+	case TypeTagU32:
+		if v, ok := argVal.(uint32); ok {
+			return TransactionArgumentU32{v}, nil
+		}
 	case TypeTagU64:
 		if v, ok := argVal.(uint64); ok {
 			return TransactionArgumentU64{v}, nil
@@ -354,6 +578,16 @@ func argToTransactionArgument(argVal any, argType TypeTag) (TransactionArgument,
 		if v, ok := argVal.(*big.Int); ok {
 			return TransactionArgumentU128{Uint128{v}}, nil
 		}
+	case TypeTagU256:
+		if v, ok := argVal.(TransactionArgumentU256); ok {
+			return v, nil
+		}
+		if v, ok := argVal.(Uint256); ok {
+			return TransactionArgumentU256{v}, nil
+		}
+		if v, ok := argVal.(*big.Int); ok {
+			return TransactionArgumentU256{Uint256{v}}, nil
+		}
 	case TypeTagAddress:
 		if v, ok := argVal.(AccountAddress); ok {
 			return TransactionArgumentAddress{v}, nil
@@ -382,3 +616,100 @@ func argToTransactionArgument(argVal any, argType TypeTag) (TransactionArgument,
 
 	return nil, fmt.Errorf("Invalid argument %v.", argVal)
 }
+
+// deserializeArg is the reverse of `serializeArg`, it decodes a BCS argument into Go value according to argType:
+// bool, uint8, uint16, uint32, uint64, *big.Int (u128, u256), AccountAddress, []byte (vector<u8>), []any (other vectors), string (0x1::string::String)
+// nil or the inner value (0x1::option::Option), AccountAddress (0x1::object::Object), *big.Rat (fixed point)
+func deserializeArg(decoder *lcs.Decoder, argType TypeTag) (any, error) {
+	switch tag := argType.(type) {
+	case TypeTagBool:
+		var v bool
+		err := decoder.Decode(&v)
+		return v, err
+	case TypeTagU8:
+		var v uint8
+		err := decoder.Decode(&v)
+		return v, err
+	case TypeTagU16:
+		var v uint16
+		err := decoder.Decode(&v)
+		return v, err
+	case TypeTagU32:
+		var v uint32
+		err := decoder.Decode(&v)
+		return v, err
+	case TypeTagU64:
+		var v uint64
+		err := decoder.Decode(&v)
+		return v, err
+	case TypeTagU128:
+		var v Uint128
+		err := decoder.Decode(&v)
+		return v.Int, err
+	case TypeTagU256:
+		var v Uint256
+		err := decoder.Decode(&v)
+		return v.Int, err
+	case TypeTagAddress:
+		var v AccountAddress
+		err := decoder.Decode(&v)
+		return v, err
+	case TypeTagVector:
+		if _, ok := tag.Value.(TypeTagU8); ok {
+			return decoder.DecodeBytes()
+		}
+		length, err := decoder.DecodeUleb128()
+		if err != nil {
+			return nil, err
+		}
+		res := []any{}
+		for i := uint64(0); i < length; i++ {
+			item, err := deserializeArg(decoder, tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, item)
+		}
+		return res, nil
+	case TypeTagStruct:
+		switch tag.ShortFunctionName() {
+		case "0x1::string::String":
+			var v string
+			err := decoder.Decode(&v)
+			return v, err
+		case "0x1::option::Option":
+			if len(tag.TypeArgs) != 1 {
+				return nil, errors.New("Invalid option type.")
+			}
+			length, err := decoder.DecodeUleb128()
+			if err != nil {
+				return nil, err
+			}
+			switch length {
+			case 0:
+				return nil, nil
+			case 1:
+				return deserializeArg(decoder, tag.TypeArgs[0])
+			}
+			return nil, fmt.Errorf("Invalid option length %v.", length)
+		case "0x1::object::Object":
+			var v AccountAddress
+			err := decoder.Decode(&v)
+			return v, err
+		case "0x1::fixed_point32::FixedPoint32":
+			var v uint64
+			if err := decoder.Decode(&v); err != nil {
+				return nil, err
+			}
+			return fixedPointFromRawValue(big.NewInt(0).SetUint64(v), 32), nil
+		case "0x1::fixed_point64::FixedPoint64":
+			var v Uint128
+			if err := decoder.Decode(&v); err != nil {
+				return nil, err
+			}
+			return fixedPointFromRawValue(v.Int, 64), nil
+		}
+		return nil, fmt.Errorf("Unsupported struct arg type %v.", tag)
+	}
+	return nil, errors.New("Unsupported arg type.")
+}
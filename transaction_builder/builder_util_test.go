@@ -2,6 +2,7 @@ package transactionbuilder
 
 import (
 	"bytes"
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -11,7 +12,7 @@ import (
 )
 
 func TestTypeTagParser_ParseTypeTag(t *testing.T) {
-	// test TypeTag bool, u8, u64, u128, address, vector
+	// test TypeTag bool, u8, u16, u32, u64, u128, u256, address, vector
 	TestTypeTagParser_ParseTypeTag_Basic(t)
 	// test TypeTag struct
 	TestTypeTagParser_ParseTypeTag_Struct(t)
@@ -46,6 +47,21 @@ func TestTypeTagParser_ParseTypeTag_Basic(t *testing.T) {
 			tag:  "u128",
 			want: TypeTagU128{},
 		},
+		{
+			name: "parses u16",
+			tag:  "u16",
+			want: TypeTagU16{},
+		},
+		{
+			name: "parses u32",
+			tag:  "u32",
+			want: TypeTagU32{},
+		},
+		{
+			name: "parses u256",
+			tag:  "vector<u256>",
+			want: TypeTagVector{Value: TypeTagU256{}},
+		},
 		{
 			name: "parses address",
 			tag:  "address",
@@ -153,7 +169,7 @@ func TestTypeTagParser_ParseTypeTag_ShouldError(t *testing.T) {
 	typeTagShouldError("")
 	typeTagShouldError("0x1::<::CoinStore<0x1::test_coin::AptosCoin,")
 	typeTagShouldError("0x1::test_coin::><0x1::test_coin::AptosCoin,")
-	typeTagShouldError("u32")
+	typeTagShouldError("u512")
 }
 
 func Test_serializeArg(t *testing.T) {
@@ -214,6 +230,67 @@ func Test_serializeArg(t *testing.T) {
 			args:    args{1222, TypeTagU128{}},
 			wantErr: true,
 		},
+		{
+			name: "serialize u16",
+			args: args{"65535", TypeTagU16{}},
+			want: []byte{0xff, 0xff},
+		},
+		{
+			name:    "error u16",
+			args:    args{65536, TypeTagU16{}},
+			wantErr: true,
+		},
+		{
+			name: "serialize u32",
+			args: args{uint32(0x12345678), TypeTagU32{}},
+			want: []byte{0x78, 0x56, 0x34, 0x12},
+		},
+		{
+			name: "serialize u256",
+			args: args{
+				big.NewInt(0).Sub(big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil), big.NewInt(1)), TypeTagU256{}},
+			want: bytes.Repeat([]byte{0xff}, 32),
+		},
+		{
+			name:    "error u256",
+			args:    args{big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil), TypeTagU256{}},
+			wantErr: true,
+		},
+		{
+			name: "serialize u256 float64 beyond int64",
+			args: args{float64(1 << 64), TypeTagU256{}},
+			want: append(make([]byte, 8), append([]byte{1}, make([]byte, 23)...)...),
+		},
+		{
+			name: "serialize u128 float64 beyond int64",
+			args: args{float64(1 << 64), TypeTagU128{}},
+			want: append(make([]byte, 8), append([]byte{1}, make([]byte, 7)...)...),
+		},
+		{
+			name: "serialize u256 int",
+			args: args{256, TypeTagU256{}},
+			want: append([]byte{0, 1}, make([]byte, 30)...),
+		},
+		{
+			name:    "error u256 fractional float64",
+			args:    args{1.5, TypeTagU256{}},
+			wantErr: true,
+		},
+		{
+			name:    "error u256 negative float64",
+			args:    args{float64(-1), TypeTagU256{}},
+			wantErr: true,
+		},
+		{
+			name:    "error u256 negative int",
+			args:    args{-1, TypeTagU256{}},
+			wantErr: true,
+		},
+		{
+			name:    "error u128 fractional float64",
+			args:    args{0.5, TypeTagU128{}},
+			wantErr: true,
+		},
 		{
 			name: "serialize account address string",
 			args: args{"0x1", TypeTagAddress{}},
@@ -245,6 +322,11 @@ func Test_serializeArg(t *testing.T) {
 			args: args{[]uint8{0x61, 0x62, 0x63}, TypeTagVector{TypeTagU8{}}},
 			want: []byte{3, 0x61, 0x62, 0x63},
 		},
+		{
+			name: "serialize empty vector",
+			args: args{[]uint64{}, TypeTagVector{TypeTagU64{}}},
+			want: []byte{0},
+		},
 		{
 			name:    "error vector",
 			args:    args{123456, TypeTagVector{TypeTagU8{}}},
@@ -261,6 +343,71 @@ func Test_serializeArg(t *testing.T) {
 			args:    args{"abc", TypeTagStruct{*AccountAddressFromHex("0x3"), "token", "Token", []TypeTag{}}},
 			wantErr: true,
 		},
+		{
+			name: "serialize option none",
+			args: args{nil, optionTag(TypeTagU64{})},
+			want: []byte{0},
+		},
+		{
+			name: "serialize option nil pointer",
+			args: args{(*uint64)(nil), optionTag(TypeTagU64{})},
+			want: []byte{0},
+		},
+		{
+			name: "serialize option pointer",
+			args: args{&[]uint64{5}[0], optionTag(TypeTagU64{})},
+			want: []byte{1, 5, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name: "serialize option big.int",
+			args: args{big.NewInt(5), optionTag(TypeTagU128{})},
+			want: append([]byte{1, 5}, make([]byte, 15)...),
+		},
+		{
+			name: "serialize option nil big.int",
+			args: args{(*big.Int)(nil), optionTag(TypeTagU128{})},
+			want: []byte{0},
+		},
+		{
+			name: "serialize option nil big.rat",
+			args: args{(*big.Rat)(nil), optionTag(fixedPointTag(64))},
+			want: []byte{0},
+		},
+		{
+			name: "serialize option string",
+			args: args{"abc", optionTag(TypeTagStruct{*AccountAddressFromHex("0x1"), "string", "String", []TypeTag{}})},
+			want: []byte{1, 3, 0x61, 0x62, 0x63},
+		},
+		{
+			name: "serialize object",
+			args: args{"0x222", TypeTagStruct{*AccountAddressFromHex("0x1"), "object", "Object", []TypeTag{TypeTagStruct{*AccountAddressFromHex("0x4"), "token", "Token", []TypeTag{}}}}},
+			want: AccountAddressFromHex("0x222")[:],
+		},
+		{
+			name: "serialize fixed point32 string",
+			args: args{"1.5", fixedPointTag(32)},
+			want: []byte{0, 0, 0, 0x80, 1, 0, 0, 0},
+		},
+		{
+			name: "serialize fixed point32 rat",
+			args: args{big.NewRat(1, 3), fixedPointTag(32)},
+			want: []byte{0x55, 0x55, 0x55, 0x55, 0, 0, 0, 0},
+		},
+		{
+			name:    "error fixed point32 too large",
+			args:    args{"4294967296", fixedPointTag(32)},
+			wantErr: true,
+		},
+		{
+			name:    "error fixed point32 negative",
+			args:    args{"-1", fixedPointTag(32)},
+			wantErr: true,
+		},
+		{
+			name: "serialize fixed point64",
+			args: args{"0.25", fixedPointTag(64)},
+			want: []byte{0, 0, 0, 0, 0, 0, 0, 0x40, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -325,6 +472,21 @@ func Test_argToTransactionArgument(t *testing.T) {
 			args:    args{"u64", TypeTagU64{}},
 			wantErr: true,
 		},
+		{
+			name: "convert u16",
+			args: args{uint16(123), TypeTagU16{}},
+			want: TransactionArgumentU16{123},
+		},
+		{
+			name: "convert u32",
+			args: args{uint32(123), TypeTagU32{}},
+			want: TransactionArgumentU32{123},
+		},
+		{
+			name: "convert u256 big.int",
+			args: args{big.NewInt(98765), TypeTagU256{}},
+			want: TransactionArgumentU256{Uint256{big.NewInt(98765)}},
+		},
 		{
 			name: "convert u128",
 			args: args{TransactionArgumentU128{Uint128{big.NewInt(123)}}, TypeTagU128{}},
@@ -392,3 +554,38 @@ func AccountAddressFromHex(hex string) *AccountAddress {
 	}
 	return addr
 }
+
+func optionTag(tag TypeTag) TypeTagStruct {
+	return TypeTagStruct{*AccountAddressFromHex("0x1"), "option", "Option", []TypeTag{tag}}
+}
+
+func fixedPointTag(bits int) TypeTagStruct {
+	module := fmt.Sprintf("fixed_point%v", bits)
+	name := fmt.Sprintf("FixedPoint%v", bits)
+	return TypeTagStruct{*AccountAddressFromHex("0x1"), Identifier(module), Identifier(name), []TypeTag{}}
+}
+
+func Test_deserializeArg_Struct(t *testing.T) {
+	tests := []struct {
+		name    string
+		argVal  any
+		argType TypeTag
+		want    any
+	}{
+		{"option none", nil, optionTag(TypeTagU8{}), nil},
+		{"option some", uint8(3), optionTag(TypeTagU8{}), uint8(3)},
+		{"object", "0x222", TypeTagStruct{*AccountAddressFromHex("0x1"), "object", "Object", []TypeTag{}}, *AccountAddressFromHex("0x222")},
+		{"fixed point32", "0.75", fixedPointTag(32), big.NewRat(3, 4)},
+		{"fixed point64", big.NewRat(7, 2), fixedPointTag(64), big.NewRat(7, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			err := serializeArg(tt.argVal, tt.argType, lcs.NewEncoder(&b))
+			assert.Nil(t, err)
+			got, err := deserializeArg(lcs.NewDecoder(&b), tt.argType)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -49,7 +49,11 @@ func (a AccountAddress) ToString() string {
 }
 
 func (a AccountAddress) ToShortString() string {
-	return "0x" + strings.TrimLeft(hex.EncodeToString(a[:]), "0")
+	short := strings.TrimLeft(hex.EncodeToString(a[:]), "0")
+	if short == "" {
+		short = "0"
+	}
+	return "0x" + short
 }
 
 func BCSSerializeBasicValue[T bool | int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64 | string](t T) []byte {
@@ -83,6 +87,32 @@ func (u *Uint128) UnmarshalLCS(d *lcs.Decoder) error {
 	return nil
 }
 
+type Uint256 struct{ *big.Int }
+
+func (u Uint256) MarshalLCS(e *lcs.Encoder) error {
+	if u.Sign() == -1 {
+		return errors.New("Invalid U256: invalid number.")
+	}
+	bytes := u.Bytes()
+	if len(bytes) > 32 {
+		return errors.New("Invalid U256: too large number.")
+	}
+	ReverseBytes(bytes)
+	result := [32]byte{}
+	copy(result[:], bytes)
+	return e.EncodeFixedBytes(result[:])
+}
+
+func (u *Uint256) UnmarshalLCS(d *lcs.Decoder) error {
+	bytes, err := d.DecodeFixedBytes(32)
+	if err != nil {
+		return err
+	}
+	ReverseBytes(bytes)
+	u.Int = big.NewInt(0).SetBytes(bytes)
+	return nil
+}
+
 func ReverseBytes(b []byte) {
 	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
 		b[i], b[j] = b[j], b[i]
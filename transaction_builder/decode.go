@@ -0,0 +1,78 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/lcs"
+)
+
+// DecodeRawTransaction decodes the BCS bytes of RawTransaction, e.g. the signing message without prefix
+func DecodeRawTransaction(data []byte) (*RawTransaction, error) {
+	txn := &RawTransaction{}
+	if err := lcs.Unmarshal(data, txn); err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// DecodeSignedTransaction decodes the BCS bytes of SignedTransaction, e.g. the bytes submitted to the chain
+func DecodeSignedTransaction(data []byte) (*SignedTransaction, error) {
+	txn := &SignedTransaction{}
+	if err := lcs.Unmarshal(data, txn); err != nil {
+		return nil, err
+	}
+	if txn.Transaction == nil {
+		return nil, errors.New("Invalid signed transaction.")
+	}
+	return txn, nil
+}
+
+/**
+ * Decodes the BCS arguments of the entry function payload into Go values according to the abi,
+ * the values are of the types returned by `DecodeArg`.
+ */
+func DecodeEntryFunctionArgs(payload *TransactionPayloadEntryFunction, abi *EntryFunctionABI) ([]any, error) {
+	if payload.ModuleName != abi.ModuleName || string(payload.FunctionName) != abi.Name {
+		return nil, fmt.Errorf("The abi of %v::%v cannot decode the payload of %v::%v.",
+			abi.ModuleName.Address.ToShortString(), abi.ModuleName.Name,
+			payload.ModuleName.Address.ToShortString(), payload.FunctionName)
+	}
+	if len(payload.Args) != len(abi.Args) {
+		return nil, fmt.Errorf("Wrong number of args: expected %v, received %v.", len(abi.Args), len(payload.Args))
+	}
+	res := []any{}
+	for i, arg := range abi.Args {
+		value, err := DecodeArg(payload.Args[i], arg.TypeTag)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid arg %v: %v", arg.Name, err)
+		}
+		res = append(res, value)
+	}
+	return res, nil
+}
+
+/**
+ * Decodes a BCS argument into Go value according to argType.
+ * bool, u8, u16, u32, u64 -> bool, uint8, uint16, uint32, uint64
+ * u128, u256 -> *big.Int
+ * address -> AccountAddress
+ * vector<u8> -> []byte
+ * vector<T> -> []any
+ * 0x1::string::String -> string
+ * 0x1::option::Option<T> -> nil or the value of T
+ * 0x1::object::Object<T> -> AccountAddress
+ * 0x1::fixed_point32::FixedPoint32, 0x1::fixed_point64::FixedPoint64 -> *big.Rat
+ */
+func DecodeArg(data []byte, argType TypeTag) (any, error) {
+	reader := bytes.NewReader(data)
+	value, err := deserializeArg(lcs.NewDecoder(reader), argType)
+	if err != nil {
+		return nil, err
+	}
+	if reader.Len() != 0 {
+		return nil, errors.New("Unexpected bytes after the arg.")
+	}
+	return value, nil
+}
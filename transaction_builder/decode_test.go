@@ -0,0 +1,125 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSignedTransaction(t *testing.T) {
+	publicKey := Ed25519PublicKey{PublicKey: bytes.Repeat([]byte{1}, 32)}
+	signature := Ed25519Signature{Signature: bytes.Repeat([]byte{2}, 64)}
+	multiPublicKey, err := NewMultiEd25519PublicKey([][]byte{bytes.Repeat([]byte{3}, 32), bytes.Repeat([]byte{4}, 32)}, 1)
+	require.Nil(t, err)
+	multiSignature, err := NewMultiEd25519Signature([][]byte{bytes.Repeat([]byte{5}, 64)}, []uint8{1})
+	require.Nil(t, err)
+
+	payloads := []TransactionPayload{
+		TransactionPayloadScript{
+			Code:   []byte{0xa1, 0x1c},
+			TyArgs: []TypeTag{TypeTagU8{}, TypeTagVector{Value: TypeTagU256{}}},
+			Args: []TransactionArgument{
+				TransactionArgumentU8{1},
+				TransactionArgumentU64{2},
+				TransactionArgumentU128{Uint128{big.NewInt(3)}},
+				TransactionArgumentAddress{*AccountAddressFromHex("0x4")},
+				TransactionArgumentU8Vector{[]byte{5}},
+				TransactionArgumentBool{true},
+				TransactionArgumentU16{6},
+				TransactionArgumentU32{7},
+				TransactionArgumentU256{Uint256{big.NewInt(8)}},
+			},
+		},
+		TransactionPayloadModuleBundle{},
+		TransactionPayloadEntryFunction{
+			ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "coin"},
+			FunctionName: "transfer",
+			TyArgs:       []TypeTag{TypeTagStruct{Address: *AccountAddressFromHex("0x1"), ModuleName: "aptos_coin", Name: "AptosCoin", TypeArgs: []TypeTag{}}},
+			Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+		},
+		TransactionPayloadMultisig{MultisigAddress: *AccountAddressFromHex("0xabc")},
+	}
+	authenticators := []TransactionAuthenticator{
+		TransactionAuthenticatorEd25519{PublicKey: publicKey, Signature: signature},
+		TransactionAuthenticatorMultiEd25519{PublicKey: *multiPublicKey, Signature: *multiSignature},
+		TransactionAuthenticatorMultiAgent{
+			Sender:                   AccountAuthenticatorEd25519{PublicKey: publicKey, Signature: signature},
+			SecondarySignerAddresses: []AccountAddress{*AccountAddressFromHex("0x2")},
+			SecondarySigners:         []AccountAuthenticator{AccountAuthenticatorMultiEd25519{PublicKey: *multiPublicKey, Signature: *multiSignature}},
+		},
+	}
+
+	for _, payload := range payloads {
+		for _, authenticator := range authenticators {
+			rawTxn := &RawTransaction{
+				Sender:                  *AccountAddressFromHex("0x1234"),
+				SequenceNumber:          9,
+				Payload:                 payload,
+				MaxGasAmount:            2000,
+				GasUnitPrice:            100,
+				ExpirationTimestampSecs: 1660000000,
+				ChainId:                 2,
+			}
+			data, err := lcs.Marshal(&SignedTransaction{Transaction: rawTxn, Authenticator: authenticator})
+			require.Nil(t, err)
+
+			signedTxn, err := DecodeSignedTransaction(data)
+			require.Nil(t, err)
+			require.Equal(t, rawTxn, signedTxn.Transaction)
+			require.Equal(t, authenticator, signedTxn.Authenticator)
+
+			encoded, err := lcs.Marshal(signedTxn)
+			require.Nil(t, err)
+			require.Equal(t, data, encoded)
+
+			rawData, err := lcs.Marshal(rawTxn)
+			require.Nil(t, err)
+			decodedRawTxn, err := DecodeRawTransaction(rawData)
+			require.Nil(t, err)
+			require.Equal(t, rawTxn, decodedRawTxn)
+		}
+	}
+
+	_, err = DecodeSignedTransaction([]byte{1, 2, 3})
+	require.NotNil(t, err)
+}
+
+func TestDecodeEntryFunctionArgs(t *testing.T) {
+	abi := &EntryFunctionABI{
+		Name:       "mint",
+		ModuleName: ModuleId{Address: *AccountAddressFromHex("0x3"), Name: "token"},
+		Args: []ArgumentABI{
+			{Name: "receiver", TypeTag: TypeTagAddress{}},
+			{Name: "amounts", TypeTag: TypeTagVector{Value: TypeTagU64{}}},
+			{Name: "name", TypeTag: TypeTagStruct{Address: *AccountAddressFromHex("0x1"), ModuleName: "string", Name: "String", TypeArgs: []TypeTag{}}},
+			{Name: "supply", TypeTag: TypeTagU128{}},
+		},
+	}
+	values := []any{"0x22", []uint64{1, 2}, "NFT", big.NewInt(1000)}
+	payload := &TransactionPayloadEntryFunction{
+		ModuleName:   abi.ModuleName,
+		FunctionName: Identifier(abi.Name),
+		TyArgs:       []TypeTag{},
+	}
+	for i, arg := range abi.Args {
+		var b bytes.Buffer
+		err := serializeArg(values[i], arg.TypeTag, lcs.NewEncoder(&b))
+		require.Nil(t, err)
+		payload.Args = append(payload.Args, b.Bytes())
+	}
+
+	args, err := DecodeEntryFunctionArgs(payload, abi)
+	require.Nil(t, err)
+	require.Equal(t, []any{*AccountAddressFromHex("0x22"), []any{uint64(1), uint64(2)}, "NFT", big.NewInt(1000)}, args)
+
+	payload.Args[3] = append(payload.Args[3], 0)
+	_, err = DecodeEntryFunctionArgs(payload, abi)
+	require.NotNil(t, err)
+
+	payload.FunctionName = "burn"
+	_, err = DecodeEntryFunctionArgs(payload, abi)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,160 @@
+package transactionbuilder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+)
+
+const (
+	DefaultMaxGasAmount  = 20000
+	DefaultExpSecFromNow = 600
+	DefaultGasMultiplier = 1.5
+)
+
+// TransactionClient is implemented by `aptosclient.RestClient`
+type TransactionClient interface {
+	ChainId() int
+	GetAccountWithContext(ctx context.Context, address string) (*aptostypes.AccountCoreData, error)
+	EstimateGasPriceWithContext(ctx context.Context) (uint64, error)
+	SimulateSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) ([]*aptostypes.Transaction, error)
+	SubmitSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) (*aptostypes.Transaction, error)
+}
+
+// TransactionSigner signs a raw transaction and returns the BCS bytes of the signed transaction.
+// `TransactionBuilderEd25519`, `TransactionBuilderMultiEd25519` and `TransactionBuilderSigner` are signers.
+type TransactionSigner interface {
+	Sign(rawTxn *RawTransaction) ([]byte, error)
+}
+
+// SimulationSigner signs a raw transaction with invalid signatures for simulation.
+// `TransactionBuilderEd25519`, `TransactionBuilderMultiEd25519` and `TransactionBuilderSigner` are simulation signers.
+type SimulationSigner interface {
+	SignForSimulation(rawTxn *RawTransaction) ([]byte, error)
+}
+
+// TransactionFactory builds transactions with the fields fetched from chain, then signs and submits them.
+type TransactionFactory struct {
+	Client TransactionClient
+	// The non-zero fields of Config are used as is, the zero fields will be filled:
+	// SequenceNumber from the sender's account, GasUnitPrice from gas estimation, ChainId from client,
+	// MaxGasAmount with `DefaultMaxGasAmount` and ExpSecFromNow with `DefaultExpSecFromNow`.
+	// The Sender of config is always replaced by the sender of the transaction.
+	// A zero SequenceNumber always means fetching it from chain, unless `FixedSequenceNumber` is true.
+	Config ABIBuilderConfig
+	// If true, `Config.SequenceNumber` is used as is even if it's 0,
+	// e.g. the first transaction of a new account that doesn't exist on chain yet.
+	FixedSequenceNumber bool
+	// If true, the transaction will be simulated before submission and `MaxGasAmount` will be set
+	// to the gas used multiplied by `GasMultiplier`.
+	SimulateBeforeSubmit bool
+	// Default is `DefaultGasMultiplier`
+	GasMultiplier float64
+}
+
+func NewTransactionFactory(client TransactionClient) *TransactionFactory {
+	return &TransactionFactory{Client: client}
+}
+
+// FillConfig returns a copy of `Config` whose zero fields are filled with the sender's data from chain
+func (f *TransactionFactory) FillConfig(ctx context.Context, sender AccountAddress) (*ABIBuilderConfig, error) {
+	config := f.Config
+	config.Sender = sender
+	if config.SequenceNumber == 0 && !f.FixedSequenceNumber {
+		account, err := f.Client.GetAccountWithContext(ctx, sender.ToString())
+		if err != nil {
+			return nil, err
+		}
+		config.SequenceNumber = account.SequenceNumber
+	}
+	if config.GasUnitPrice == 0 {
+		price, err := f.Client.EstimateGasPriceWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		config.GasUnitPrice = price
+	}
+	if config.ChainId == 0 {
+		config.ChainId = uint8(f.Client.ChainId())
+	}
+	if config.MaxGasAmount == 0 {
+		config.MaxGasAmount = DefaultMaxGasAmount
+	}
+	if config.ExpSecFromNow == 0 {
+		config.ExpSecFromNow = DefaultExpSecFromNow
+	}
+	return &config, nil
+}
+
+// BuildRawTransaction builds a RawTransaction of the payload with the filled config
+func (f *TransactionFactory) BuildRawTransaction(ctx context.Context, sender AccountAddress, payload TransactionPayload) (*RawTransaction, error) {
+	config, err := f.FillConfig(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	return config.BuildRawTransaction(payload), nil
+}
+
+// Simulate simulates the transaction, an error is returned if the simulation is not success
+func (f *TransactionFactory) Simulate(ctx context.Context, signer SimulationSigner, rawTxn *RawTransaction) (*aptostypes.Transaction, error) {
+	signedTxn, err := signer.SignForSimulation(rawTxn)
+	if err != nil {
+		return nil, err
+	}
+	txns, err := f.Client.SimulateSignedBCSTransactionWithContext(ctx, signedTxn)
+	if err != nil {
+		return nil, err
+	}
+	if len(txns) == 0 {
+		return nil, errors.New("Simulation result is empty.")
+	}
+	txn := txns[0]
+	if !txn.Success {
+		return txn, fmt.Errorf("Simulation failed: %v", txn.VmStatus)
+	}
+	return txn, nil
+}
+
+// SignAndSubmit signs the transaction and submits it, the pending transaction is returned
+func (f *TransactionFactory) SignAndSubmit(ctx context.Context, signer TransactionSigner, rawTxn *RawTransaction) (*aptostypes.Transaction, error) {
+	signedTxn, err := signer.Sign(rawTxn)
+	if err != nil {
+		return nil, err
+	}
+	return f.Client.SubmitSignedBCSTransactionWithContext(ctx, signedTxn)
+}
+
+/**
+ * Builds the transaction of payload, signs and submits it.
+ * If `SimulateBeforeSubmit` is true, the signer must also be a `SimulationSigner`.
+ * @returns The raw transaction that is submitted and the pending transaction
+ */
+func (f *TransactionFactory) Submit(ctx context.Context, sender AccountAddress, signer TransactionSigner, payload TransactionPayload) (*RawTransaction, *aptostypes.Transaction, error) {
+	rawTxn, err := f.BuildRawTransaction(ctx, sender, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	if f.SimulateBeforeSubmit {
+		simulationSigner, ok := signer.(SimulationSigner)
+		if !ok {
+			return nil, nil, errors.New("The signer cannot sign for simulation.")
+		}
+		simulated, err := f.Simulate(ctx, simulationSigner, rawTxn)
+		if err != nil {
+			return nil, nil, err
+		}
+		rawTxn.MaxGasAmount = maxGasAmountWithMultiplier(simulated.GasUsed, f.GasMultiplier)
+	}
+	pending, err := f.SignAndSubmit(ctx, signer, rawTxn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rawTxn, pending, nil
+}
+
+// SubmitWithSigner is the same as `Submit`, the sender is the address of signer
+func (f *TransactionFactory) SubmitWithSigner(ctx context.Context, signer Signer, payload TransactionPayload) (*RawTransaction, *aptostypes.Transaction, error) {
+	return f.Submit(ctx, signer.AccountAddress(), NewTransactionBuilderSigner(signer), payload)
+}
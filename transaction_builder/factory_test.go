@@ -0,0 +1,105 @@
+package transactionbuilder
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/coming-chat/go-aptos/aptostypes"
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTransactionClient struct {
+	sequenceNumber uint64
+	gasUsed        uint64
+	simulated      *SignedTransaction
+	submitted      *SignedTransaction
+	accountErr     error
+}
+
+func (c *mockTransactionClient) ChainId() int {
+	return 2
+}
+
+func (c *mockTransactionClient) GetAccountWithContext(ctx context.Context, address string) (*aptostypes.AccountCoreData, error) {
+	if c.accountErr != nil {
+		return nil, c.accountErr
+	}
+	return &aptostypes.AccountCoreData{SequenceNumber: c.sequenceNumber}, nil
+}
+
+func (c *mockTransactionClient) EstimateGasPriceWithContext(ctx context.Context) (uint64, error) {
+	return 150, nil
+}
+
+func (c *mockTransactionClient) EstimateGasPricesWithContext(ctx context.Context) (*aptostypes.GasEstimation, error) {
+	return &aptostypes.GasEstimation{DeprioritizedGasEstimate: 100, GasEstimate: 150, PrioritizedGasEstimate: 200}, nil
+}
+
+func (c *mockTransactionClient) SimulateSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) ([]*aptostypes.Transaction, error) {
+	c.simulated = &SignedTransaction{}
+	if err := lcs.Unmarshal(signedTxn, c.simulated); err != nil {
+		return nil, err
+	}
+	return []*aptostypes.Transaction{{Success: true, GasUsed: c.gasUsed}}, nil
+}
+
+func (c *mockTransactionClient) SubmitSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) (*aptostypes.Transaction, error) {
+	c.submitted = &SignedTransaction{}
+	if err := lcs.Unmarshal(signedTxn, c.submitted); err != nil {
+		return nil, err
+	}
+	return &aptostypes.Transaction{Type: aptostypes.TypePendingTransaction}, nil
+}
+
+func TestTransactionFactory_Submit(t *testing.T) {
+	account, err := aptosaccount.NewAccountWithMnemonic(Mnemonic)
+	require.Nil(t, err)
+	signer := NewTransactionBuilderEd25519(func(sm SigningMessage) []byte {
+		return account.Sign(sm, "")
+	}, account.PublicKey)
+
+	client := &mockTransactionClient{sequenceNumber: 7, gasUsed: 10}
+	factory := NewTransactionFactory(client)
+	factory.SimulateBeforeSubmit = true
+
+	payload := TransactionPayloadEntryFunction{
+		ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "aptos_account"},
+		FunctionName: "transfer",
+		TyArgs:       []TypeTag{},
+		Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+	}
+	rawTxn, _, err := factory.Submit(context.Background(), account.AuthKey, signer, payload)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), rawTxn.SequenceNumber)
+	require.Equal(t, uint64(150), rawTxn.GasUnitPrice)
+	require.Equal(t, uint64(15), rawTxn.MaxGasAmount)
+	require.Equal(t, uint8(2), rawTxn.ChainId)
+	require.Equal(t, AccountAddress(account.AuthKey), rawTxn.Sender)
+
+	require.Equal(t, uint64(DefaultMaxGasAmount), client.simulated.Transaction.MaxGasAmount)
+	require.Equal(t, rawTxn, client.submitted.Transaction)
+	authenticator := client.submitted.Authenticator.(TransactionAuthenticatorEd25519)
+	signingMessage, err := rawTxn.GetSigningMessage()
+	require.Nil(t, err)
+	require.True(t, ed25519.Verify(account.PublicKey, signingMessage, authenticator.Signature.Signature))
+}
+
+func TestTransactionFactory_FillConfig_FixedSequenceNumber(t *testing.T) {
+	// the account doesn't exist on chain
+	client := &mockTransactionClient{accountErr: &aptostypes.RestError{Code: 404, Message: "Account not found"}}
+	factory := NewTransactionFactory(client)
+	sender := *AccountAddressFromHex("0x1234")
+
+	_, err := factory.FillConfig(context.Background(), sender)
+	require.Equal(t, client.accountErr, err)
+
+	factory.FixedSequenceNumber = true
+	config, err := factory.FillConfig(context.Background(), sender)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), config.SequenceNumber)
+	require.Equal(t, sender, config.Sender)
+	require.Equal(t, uint64(150), config.GasUnitPrice)
+}
@@ -0,0 +1,93 @@
+package transactionbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/lcs"
+)
+
+/**
+ * FeePayerTransactionBuilder collects the signatures of fee payer (sponsored) transaction.
+ * The sender, secondary signers and fee payer sign the same signing message, so they can sign in different processes:
+ * each signer signs `FeePayerRawTransaction` (e.g. with `SignAccountAuthenticator`) and the authenticators are added here.
+ */
+type FeePayerTransactionBuilder struct {
+	RawTxn FeePayerRawTransaction
+
+	sender           AccountAuthenticator
+	secondarySigners []AccountAuthenticator
+	feePayer         AccountAuthenticator
+}
+
+func NewFeePayerTransactionBuilder(rawTxn *RawTransaction, secondarySignerAddresses []AccountAddress, feePayerAddress AccountAddress) *FeePayerTransactionBuilder {
+	if secondarySignerAddresses == nil {
+		secondarySignerAddresses = []AccountAddress{}
+	}
+	return &FeePayerTransactionBuilder{
+		RawTxn: FeePayerRawTransaction{
+			RawTransaction:           *rawTxn,
+			SecondarySignerAddresses: secondarySignerAddresses,
+			FeePayerAddress:          feePayerAddress,
+		},
+		secondarySigners: make([]AccountAuthenticator, len(secondarySignerAddresses)),
+	}
+}
+
+// GetSigningMessage returns the message that all the signers should sign
+func (b *FeePayerTransactionBuilder) GetSigningMessage() (SigningMessage, error) {
+	return b.RawTxn.GetSigningMessage()
+}
+
+func (b *FeePayerTransactionBuilder) AddSenderAuthenticator(authenticator AccountAuthenticator) {
+	b.sender = authenticator
+}
+
+func (b *FeePayerTransactionBuilder) AddSecondarySignerAuthenticator(address AccountAddress, authenticator AccountAuthenticator) error {
+	for i, addr := range b.RawTxn.SecondarySignerAddresses {
+		if addr == address {
+			b.secondarySigners[i] = authenticator
+			return nil
+		}
+	}
+	return fmt.Errorf("%v is not a secondary signer of the transaction.", address.ToShortString())
+}
+
+func (b *FeePayerTransactionBuilder) AddFeePayerAuthenticator(authenticator AccountAuthenticator) {
+	b.feePayer = authenticator
+}
+
+// Build returns the signed transaction, an error is returned if any signature is missing
+func (b *FeePayerTransactionBuilder) Build() (*SignedTransaction, error) {
+	if b.sender == nil {
+		return nil, errors.New("Missing the signature of sender.")
+	}
+	for i, authenticator := range b.secondarySigners {
+		if authenticator == nil {
+			return nil, fmt.Errorf("Missing the signature of secondary signer %v.", b.RawTxn.SecondarySignerAddresses[i].ToShortString())
+		}
+	}
+	if b.feePayer == nil {
+		return nil, errors.New("Missing the signature of fee payer.")
+	}
+	rawTxn := b.RawTxn.RawTransaction
+	return &SignedTransaction{
+		Transaction: &rawTxn,
+		Authenticator: TransactionAuthenticatorFeePayer{
+			Sender:                   b.sender,
+			SecondarySignerAddresses: b.RawTxn.SecondarySignerAddresses,
+			SecondarySigners:         b.secondarySigners,
+			FeePayerAddress:          b.RawTxn.FeePayerAddress,
+			FeePayerSigner:           b.feePayer,
+		},
+	}, nil
+}
+
+// BuildBCS returns the BCS bytes of the signed transaction, which can be submitted
+func (b *FeePayerTransactionBuilder) BuildBCS() ([]byte, error) {
+	signedTxn, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return lcs.Marshal(signedTxn)
+}
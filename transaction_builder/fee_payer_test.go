@@ -0,0 +1,80 @@
+package transactionbuilder
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func newTestEd25519Builder(seed byte) *TransactionBuilderEd25519 {
+	s := make([]byte, ed25519.SeedSize)
+	s[0] = seed
+	privateKey := ed25519.NewKeyFromSeed(s)
+	return NewTransactionBuilderEd25519(func(sm SigningMessage) []byte {
+		return ed25519.Sign(privateKey, sm)
+	}, privateKey.Public().(ed25519.PublicKey))
+}
+
+func TestFeePayerTransactionBuilder(t *testing.T) {
+	sender, secondary, feePayer := newTestEd25519Builder(1), newTestEd25519Builder(2), newTestEd25519Builder(3)
+	rawTxn := &RawTransaction{
+		Sender:         *AccountAddressFromHex("0x1234"),
+		SequenceNumber: 3,
+		Payload: TransactionPayloadEntryFunction{
+			ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "aptos_account"},
+			FunctionName: "transfer",
+			TyArgs:       []TypeTag{},
+			Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+		},
+		MaxGasAmount:            2000,
+		GasUnitPrice:            100,
+		ExpirationTimestampSecs: 1660000000,
+		ChainId:                 2,
+	}
+	secondaryAddress := *AccountAddressFromHex("0x22")
+	feePayerAddress := *AccountAddressFromHex("0x33")
+	builder := NewFeePayerTransactionBuilder(rawTxn, []AccountAddress{secondaryAddress}, feePayerAddress)
+
+	signingMessage, err := builder.GetSigningMessage()
+	require.Nil(t, err)
+	salt := sha3.Sum256([]byte(RAW_TRANSACTION_WITH_DATA_SALT))
+	rawTxnBytes, err := lcs.Marshal(rawTxn)
+	require.Nil(t, err)
+	require.Equal(t, salt[:], []byte(signingMessage[:32]))
+	require.Equal(t, byte(1), signingMessage[32]) // variant of fee payer
+	require.Equal(t, rawTxnBytes, []byte(signingMessage[33:33+len(rawTxnBytes)]))
+
+	// the signers sign the fee payer transaction separately
+	senderAuth, err := sender.SignAccountAuthenticator(&builder.RawTxn)
+	require.Nil(t, err)
+	secondaryAuth, err := secondary.SignAccountAuthenticator(&builder.RawTxn)
+	require.Nil(t, err)
+	feePayerAuth, err := feePayer.SignAccountAuthenticator(&builder.RawTxn)
+	require.Nil(t, err)
+
+	builder.AddSenderAuthenticator(senderAuth)
+	_, err = builder.Build()
+	require.NotNil(t, err)
+	require.NotNil(t, builder.AddSecondarySignerAuthenticator(feePayerAddress, secondaryAuth))
+	require.Nil(t, builder.AddSecondarySignerAuthenticator(secondaryAddress, secondaryAuth))
+	_, err = builder.Build()
+	require.NotNil(t, err)
+	builder.AddFeePayerAuthenticator(feePayerAuth)
+
+	data, err := builder.BuildBCS()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Equal(t, rawTxn, signedTxn.Transaction)
+	authenticator := signedTxn.Authenticator.(TransactionAuthenticatorFeePayer)
+	require.Equal(t, feePayerAddress, authenticator.FeePayerAddress)
+	require.Equal(t, []AccountAddress{secondaryAddress}, authenticator.SecondarySignerAddresses)
+	for _, auth := range []AccountAuthenticator{authenticator.Sender, authenticator.SecondarySigners[0], authenticator.FeePayerSigner} {
+		ed25519Auth := auth.(AccountAuthenticatorEd25519)
+		require.True(t, ed25519.Verify(ed25519Auth.PublicKey.PublicKey, signingMessage, ed25519Auth.Signature.Signature))
+	}
+	require.Equal(t, feePayer.PublicKey, []byte(authenticator.FeePayerSigner.(AccountAuthenticatorEd25519).PublicKey.PublicKey))
+}
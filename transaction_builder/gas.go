@@ -0,0 +1,94 @@
+package transactionbuilder
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+)
+
+// GasEstimateClient is implemented by `aptosclient.RestClient`
+type GasEstimateClient interface {
+	EstimateGasPricesWithContext(ctx context.Context) (*aptostypes.GasEstimation, error)
+	SimulateSignedBCSTransactionWithContext(ctx context.Context, signedTxn []byte) ([]*aptostypes.Transaction, error)
+}
+
+type GasEstimate struct {
+	// The gas used by the simulation
+	GasUsed uint64
+	// Recommended max gas amount, GasUsed * multiplier
+	MaxGasAmount uint64
+
+	GasUnitPrice              uint64
+	DeprioritizedGasUnitPrice uint64
+	PrioritizedGasUnitPrice   uint64
+}
+
+// GasEstimator simulates transactions to estimate the gas
+type GasEstimator struct {
+	Client GasEstimateClient
+	// The safety multiplier of gas used, default is `DefaultGasMultiplier`
+	Multiplier float64
+}
+
+func NewGasEstimator(client GasEstimateClient, multiplier float64) *GasEstimator {
+	return &GasEstimator{Client: client, Multiplier: multiplier}
+}
+
+/**
+ * Simulates the transaction signed by the public key via `GenerateBCSSimulation` and estimates the gas.
+ * The rawTxn will not be changed, if its `GasUnitPrice` is 0, the estimated price is used for simulation.
+ */
+func (e *GasEstimator) Estimate(ctx context.Context, publicKey ed25519.PublicKey, rawTxn *RawTransaction) (*GasEstimate, error) {
+	return e.estimate(ctx, rawTxn, func(txn *RawTransaction) ([]byte, error) {
+		return GenerateBCSSimulation(publicKey, txn)
+	})
+}
+
+// EstimateWithSigner is similar to `Estimate`, the transaction is signed by the simulation signer, e.g. `TransactionBuilderMultiEd25519`
+func (e *GasEstimator) EstimateWithSigner(ctx context.Context, signer SimulationSigner, rawTxn *RawTransaction) (*GasEstimate, error) {
+	return e.estimate(ctx, rawTxn, signer.SignForSimulation)
+}
+
+func (e *GasEstimator) estimate(ctx context.Context, rawTxn *RawTransaction, sign func(*RawTransaction) ([]byte, error)) (*GasEstimate, error) {
+	prices, err := e.Client.EstimateGasPricesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txn := *rawTxn
+	if txn.GasUnitPrice == 0 {
+		txn.GasUnitPrice = prices.GasEstimate
+	}
+	signedTxn, err := sign(&txn)
+	if err != nil {
+		return nil, err
+	}
+	results, err := e.Client.SimulateSignedBCSTransactionWithContext(ctx, signedTxn)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, errors.New("Simulation result is empty.")
+	}
+	if !results[0].Success {
+		return nil, fmt.Errorf("Simulation failed: %v", results[0].VmStatus)
+	}
+	gasUsed := results[0].GasUsed
+	return &GasEstimate{
+		GasUsed:                   gasUsed,
+		MaxGasAmount:              maxGasAmountWithMultiplier(gasUsed, e.Multiplier),
+		GasUnitPrice:              prices.GasEstimate,
+		DeprioritizedGasUnitPrice: prices.DeprioritizedGasEstimate,
+		PrioritizedGasUnitPrice:   prices.PrioritizedGasEstimate,
+	}, nil
+}
+
+func maxGasAmountWithMultiplier(gasUsed uint64, multiplier float64) uint64 {
+	if multiplier < 1 {
+		multiplier = DefaultGasMultiplier
+	}
+	return uint64(math.Ceil(float64(gasUsed) * multiplier))
+}
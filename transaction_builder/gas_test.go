@@ -0,0 +1,36 @@
+package transactionbuilder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasEstimator_Estimate(t *testing.T) {
+	account, err := aptosaccount.NewAccountWithMnemonic(Mnemonic)
+	require.Nil(t, err)
+	client := &mockTransactionClient{gasUsed: 9}
+	rawTxn := &RawTransaction{
+		Sender:       account.AuthKey,
+		Payload:      TransactionPayloadEntryFunction{},
+		MaxGasAmount: 2000,
+	}
+
+	estimate, err := NewGasEstimator(client, 2).Estimate(context.Background(), account.PublicKey, rawTxn)
+	require.Nil(t, err)
+	require.Equal(t, &GasEstimate{
+		GasUsed:                   9,
+		MaxGasAmount:              18,
+		GasUnitPrice:              150,
+		DeprioritizedGasUnitPrice: 100,
+		PrioritizedGasUnitPrice:   200,
+	}, estimate)
+	require.Equal(t, uint64(150), client.simulated.Transaction.GasUnitPrice)
+	require.Equal(t, uint64(0), rawTxn.GasUnitPrice)
+
+	estimate, err = NewGasEstimator(client, 0).Estimate(context.Background(), account.PublicKey, rawTxn)
+	require.Nil(t, err)
+	require.Equal(t, uint64(14), estimate.MaxGasAmount)
+}
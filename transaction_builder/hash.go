@@ -0,0 +1,41 @@
+package transactionbuilder
+
+import (
+	"encoding/hex"
+
+	"github.com/coming-chat/lcs"
+	"golang.org/x/crypto/sha3"
+)
+
+// The variant index of `Transaction::UserTransaction`
+const userTransactionVariant = 0
+
+/**
+ * Hash returns the hash of the signed transaction, it's the same as the `hash` of transaction reported by the node,
+ * so it can be known before submission.
+ * @returns 0x-prefixed hex string
+ */
+func (t *SignedTransaction) Hash() (string, error) {
+	data, err := lcs.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	prefixBytes := sha3.Sum256([]byte(TRANSACTION_SALT))
+	message := append(prefixBytes[:], userTransactionVariant)
+	hash := sha3.Sum256(append(message, data...))
+	return "0x" + hex.EncodeToString(hash[:]), nil
+}
+
+/**
+ * Hash returns the prehash of the user transaction, the sha3-256 of its signing message.
+ * It doesn't depend on the signatures, so it identifies the raw transaction before signing.
+ * @returns 0x-prefixed hex string
+ */
+func (t *RawTransaction) Hash() (string, error) {
+	signingMessage, err := t.GetSigningMessage()
+	if err != nil {
+		return "", err
+	}
+	hash := sha3.Sum256(signingMessage)
+	return "0x" + hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,88 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestSignedTransaction_Hash(t *testing.T) {
+	rawTxn := &RawTransaction{
+		Sender:         *AccountAddressFromHex("0x1234"),
+		SequenceNumber: 1,
+		Payload: TransactionPayloadEntryFunction{
+			ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "aptos_account"},
+			FunctionName: "transfer",
+			TyArgs:       []TypeTag{},
+			Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+		},
+		MaxGasAmount:            2000,
+		GasUnitPrice:            100,
+		ExpirationTimestampSecs: 1660000000,
+		ChainId:                 2,
+	}
+	signedTxn := &SignedTransaction{
+		Transaction: rawTxn,
+		Authenticator: TransactionAuthenticatorEd25519{
+			PublicKey: Ed25519PublicKey{PublicKey: bytes.Repeat([]byte{1}, 32)},
+			Signature: Ed25519Signature{Signature: bytes.Repeat([]byte{2}, 64)},
+		},
+	}
+
+	data, err := lcs.Marshal(signedTxn)
+	require.Nil(t, err)
+	salt := sha3.Sum256([]byte("APTOS::Transaction"))
+	expected := sha3.Sum256(append(append(salt[:], 0), data...))
+
+	hash, err := signedTxn.Hash()
+	require.Nil(t, err)
+	require.Equal(t, "0x"+hex.EncodeToString(expected[:]), hash)
+
+	rawHash, err := rawTxn.Hash()
+	require.Nil(t, err)
+	require.Len(t, rawHash, 66)
+	require.NotEqual(t, hash, rawHash)
+
+	// The prehash doesn't depend on the signature, the hash does.
+	signedTxn.Authenticator = TransactionAuthenticatorEd25519{
+		PublicKey: Ed25519PublicKey{PublicKey: bytes.Repeat([]byte{1}, 32)},
+		Signature: Ed25519Signature{Signature: bytes.Repeat([]byte{3}, 64)},
+	}
+	hash2, err := signedTxn.Hash()
+	require.Nil(t, err)
+	require.NotEqual(t, hash, hash2)
+	rawHash2, err := signedTxn.Transaction.Hash()
+	require.Nil(t, err)
+	require.Equal(t, rawHash, rawHash2)
+}
+
+func TestSignedTransaction_HashKnownAnswer(t *testing.T) {
+	// The BCS bytes and hash of the signed transaction in `TestSignedTransaction_Hash`, they are
+	// encoded and hashed by hand with sha3-256(sha3-256("APTOS::Transaction") || 0x00 || bcs)
+	// outside of this package, so both the encoding and the hashing are checked.
+	const signedTxnHex = "0000000000000000000000000000000000000000000000000000000000001234" + // sender
+		"0100000000000000" + // sequence number
+		"02" + "0000000000000000000000000000000000000000000000000000000000000001" + // entry function, module address
+		"0d6170746f735f6163636f756e74" + "087472616e73666572" + "00" + // module name, function name, type args
+		"02" + "20" + "0000000000000000000000000000000000000000000000000000000000000000" + "08" + "6400000000000000" + // args
+		"d007000000000000" + "6400000000000000" + "0097f16200000000" + "02" + // max gas, gas price, expiration, chain id
+		"00" + "20" + "0101010101010101010101010101010101010101010101010101010101010101" + // ed25519 public key
+		"40" + "02020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202"
+	const expectedHash = "0x9aaa7032bb89e1941ae675e7bbbd166c3c08ce4230d70954e9d6e094fccbb273"
+
+	signedTxnBytes, err := hex.DecodeString(signedTxnHex)
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(signedTxnBytes)
+	require.Nil(t, err)
+	data, err := lcs.Marshal(signedTxn)
+	require.Nil(t, err)
+	require.Equal(t, signedTxnBytes, data)
+
+	hash, err := signedTxn.Hash()
+	require.Nil(t, err)
+	require.Equal(t, expectedHash, hash)
+}
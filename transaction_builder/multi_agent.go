@@ -0,0 +1,115 @@
+package transactionbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/lcs"
+)
+
+/**
+ * MultiAgentTransactionBuilder collects the signatures of multi-agent transaction.
+ * The sender and secondary signers sign the same signing message (e.g. with `SignAccountAuthenticator`),
+ * then their authenticators can be added in any order.
+ */
+type MultiAgentTransactionBuilder struct {
+	RawTxn MultiAgentRawTransaction
+
+	sender           AccountAuthenticator
+	secondarySigners []AccountAuthenticator
+}
+
+func NewMultiAgentTransactionBuilder(rawTxn *RawTransaction, secondarySignerAddresses []AccountAddress) *MultiAgentTransactionBuilder {
+	if secondarySignerAddresses == nil {
+		secondarySignerAddresses = []AccountAddress{}
+	}
+	return &MultiAgentTransactionBuilder{
+		RawTxn: MultiAgentRawTransaction{
+			RawTransaction:           *rawTxn,
+			SecondarySignerAddresses: secondarySignerAddresses,
+		},
+		secondarySigners: make([]AccountAuthenticator, len(secondarySignerAddresses)),
+	}
+}
+
+// GetSigningMessage returns the message that the sender and all the secondary signers should sign
+func (b *MultiAgentTransactionBuilder) GetSigningMessage() (SigningMessage, error) {
+	return b.RawTxn.GetSigningMessage()
+}
+
+func (b *MultiAgentTransactionBuilder) AddSenderAuthenticator(authenticator AccountAuthenticator) {
+	b.sender = authenticator
+}
+
+/**
+ * Adds the authenticator of a secondary signer, the signer is found by the address derived from the public key,
+ * so it must be one of `SecondarySignerAddresses`.
+ * If the authentication key of the signer has been rotated, use `AddSecondarySignerAuthenticatorWithAddress`.
+ */
+func (b *MultiAgentTransactionBuilder) AddSecondarySignerAuthenticator(authenticator AccountAuthenticator) error {
+	address, err := accountAuthenticatorAddress(authenticator)
+	if err != nil {
+		return err
+	}
+	return b.AddSecondarySignerAuthenticatorWithAddress(address, authenticator)
+}
+
+func (b *MultiAgentTransactionBuilder) AddSecondarySignerAuthenticatorWithAddress(address AccountAddress, authenticator AccountAuthenticator) error {
+	switch authenticator.(type) {
+	case AccountAuthenticatorEd25519, AccountAuthenticatorMultiEd25519, AccountAuthenticatorSingleKey, AccountAuthenticatorMultiKey:
+	default:
+		return fmt.Errorf("Unsupported account authenticator %T.", authenticator)
+	}
+	for i, addr := range b.RawTxn.SecondarySignerAddresses {
+		if addr == address {
+			b.secondarySigners[i] = authenticator
+			return nil
+		}
+	}
+	return fmt.Errorf("%v is not a secondary signer of the transaction.", address.ToShortString())
+}
+
+// Build returns the signed transaction, an error is returned if any signature is missing
+func (b *MultiAgentTransactionBuilder) Build() (*SignedTransaction, error) {
+	if b.sender == nil {
+		return nil, errors.New("Missing the signature of sender.")
+	}
+	for i, authenticator := range b.secondarySigners {
+		if authenticator == nil {
+			return nil, fmt.Errorf("Missing the signature of secondary signer %v.", b.RawTxn.SecondarySignerAddresses[i].ToShortString())
+		}
+	}
+	rawTxn := b.RawTxn.RawTransaction
+	return &SignedTransaction{
+		Transaction: &rawTxn,
+		Authenticator: TransactionAuthenticatorMultiAgent{
+			Sender:                   b.sender,
+			SecondarySignerAddresses: b.RawTxn.SecondarySignerAddresses,
+			SecondarySigners:         b.secondarySigners,
+		},
+	}, nil
+}
+
+// BuildBCS returns the BCS bytes of the signed transaction, which can be submitted
+func (b *MultiAgentTransactionBuilder) BuildBCS() ([]byte, error) {
+	signedTxn, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return lcs.Marshal(signedTxn)
+}
+
+// accountAuthenticatorAddress returns the address derived from the public key of the authenticator
+func accountAuthenticatorAddress(authenticator AccountAuthenticator) (AccountAddress, error) {
+	switch auth := authenticator.(type) {
+	case AccountAuthenticatorEd25519:
+		return auth.PublicKey.AuthenticationKey(), nil
+	case AccountAuthenticatorMultiEd25519:
+		return auth.PublicKey.AuthenticationKey(), nil
+	case AccountAuthenticatorSingleKey:
+		return SingleKeyAuthenticationKey(auth.PublicKey)
+	case AccountAuthenticatorMultiKey:
+		return auth.PublicKey.AuthenticationKey()
+	}
+	return AccountAddress{}, fmt.Errorf("Unsupported account authenticator %T.", authenticator)
+}
@@ -0,0 +1,127 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiAgentTransactionBuilder(t *testing.T) {
+	sender, secondary := newTestEd25519Builder(1), newTestEd25519Builder(2)
+	multiKey1, multiKey2 := newTestEd25519Builder(3), newTestEd25519Builder(4)
+	multiPublicKey, err := NewMultiEd25519PublicKey([][]byte{multiKey1.PublicKey, multiKey2.PublicKey}, 1)
+	require.Nil(t, err)
+	multiSecondary := &TransactionBuilderMultiEd25519{
+		SigningFn: func(sm SigningMessage) MultiEd25519Signature {
+			signature, _ := NewMultiEd25519Signature([][]byte{multiKey2.SigningFn(sm)}, []uint8{1})
+			return *signature
+		},
+		PublicKey: *multiPublicKey,
+	}
+
+	secondaryAddress := AccountAddress(newEd25519PublicKey(t, secondary.PublicKey).AuthenticationKey())
+	multiSecondaryAddress := AccountAddress(multiPublicKey.AuthenticationKey())
+	rawTxn := &RawTransaction{
+		Sender:         *AccountAddressFromHex("0x1234"),
+		SequenceNumber: 3,
+		Payload: TransactionPayloadEntryFunction{
+			ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "multi"},
+			FunctionName: "swap",
+			TyArgs:       []TypeTag{},
+			Args:         [][]byte{},
+		},
+		MaxGasAmount:            2000,
+		GasUnitPrice:            100,
+		ExpirationTimestampSecs: 1660000000,
+		ChainId:                 2,
+	}
+	builder := NewMultiAgentTransactionBuilder(rawTxn, []AccountAddress{secondaryAddress, multiSecondaryAddress})
+	signingMessage, err := builder.GetSigningMessage()
+	require.Nil(t, err)
+	require.Equal(t, byte(0), signingMessage[32]) // variant of multi-agent
+
+	senderAuth, err := sender.SignAccountAuthenticator(&builder.RawTxn)
+	require.Nil(t, err)
+	secondaryAuth, err := secondary.SignAccountAuthenticator(&builder.RawTxn)
+	require.Nil(t, err)
+	multiSecondaryAuth, err := multiSecondary.SignAccountAuthenticator(&builder.RawTxn)
+	require.Nil(t, err)
+
+	// the sender is not a secondary signer
+	require.NotNil(t, builder.AddSecondarySignerAuthenticator(senderAuth))
+	builder.AddSenderAuthenticator(senderAuth)
+	require.Nil(t, builder.AddSecondarySignerAuthenticator(multiSecondaryAuth))
+	_, err = builder.BuildBCS()
+	require.NotNil(t, err)
+	require.Nil(t, builder.AddSecondarySignerAuthenticator(secondaryAuth))
+
+	data, err := builder.BuildBCS()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Equal(t, rawTxn, signedTxn.Transaction)
+	authenticator := signedTxn.Authenticator.(TransactionAuthenticatorMultiAgent)
+	require.Equal(t, []AccountAddress{secondaryAddress, multiSecondaryAddress}, authenticator.SecondarySignerAddresses)
+	for _, auth := range []AccountAuthenticator{authenticator.Sender, authenticator.SecondarySigners[0]} {
+		ed25519Auth := auth.(AccountAuthenticatorEd25519)
+		require.True(t, ed25519.Verify(ed25519Auth.PublicKey.PublicKey, signingMessage, ed25519Auth.Signature.Signature))
+	}
+	multiAuth := authenticator.SecondarySigners[1].(AccountAuthenticatorMultiEd25519)
+	require.True(t, ed25519.Verify(multiKey2.PublicKey, signingMessage, multiAuth.Signature.Signatures[0].Signature))
+}
+
+func newEd25519PublicKey(t *testing.T, publicKey []byte) *Ed25519PublicKey {
+	key, err := NewEd25519PublicKey(publicKey)
+	require.Nil(t, err)
+	return key
+}
+
+func TestMultiAgentTransactionBuilder_SingleKey(t *testing.T) {
+	sender := newTestAccount(1)
+	secondary, err := aptosaccount.NewSecp256k1Account(bytes.Repeat([]byte{2}, 32))
+	require.Nil(t, err)
+	senderAuthKey := sender.AuthenticationKey()
+	rawTxn := newTestRawTransaction(senderAuthKey)
+
+	builder := NewMultiAgentTransactionBuilder(rawTxn, []AccountAddress{secondary.AccountAddress()})
+	senderAuth, err := SignAccountAuthenticator(sender, &builder.RawTxn)
+	require.Nil(t, err)
+	secondaryAuth, err := SignAccountAuthenticator(secondary, &builder.RawTxn)
+	require.Nil(t, err)
+	require.IsType(t, AccountAuthenticatorSingleKey{}, secondaryAuth)
+	builder.AddSenderAuthenticator(senderAuth)
+	require.Nil(t, builder.AddSecondarySignerAuthenticator(secondaryAuth))
+
+	data, err := builder.BuildBCS()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Nil(t, signedTxn.Verify(senderAuthKey[:]))
+}
+
+func TestMultiAgentTransactionBuilder_MultiKey(t *testing.T) {
+	sender := newTestAccount(1)
+	signers, multiKey := newTestMultiKeySigners(t)
+	secondary, err := NewMultiKeySigner(*multiKey, map[uint8]Signer{0: signers[0], 1: signers[1]})
+	require.Nil(t, err)
+	senderAuthKey := sender.AuthenticationKey()
+	rawTxn := newTestRawTransaction(senderAuthKey)
+
+	builder := NewMultiAgentTransactionBuilder(rawTxn, []AccountAddress{secondary.AccountAddress()})
+	senderAuth, err := SignAccountAuthenticator(sender, &builder.RawTxn)
+	require.Nil(t, err)
+	secondaryAuth, err := SignAccountAuthenticator(secondary, &builder.RawTxn)
+	require.Nil(t, err)
+	require.IsType(t, AccountAuthenticatorMultiKey{}, secondaryAuth)
+	builder.AddSenderAuthenticator(senderAuth)
+	require.Nil(t, builder.AddSecondarySignerAuthenticator(secondaryAuth))
+
+	data, err := builder.BuildBCS()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Nil(t, signedTxn.Verify(senderAuthKey[:]))
+}
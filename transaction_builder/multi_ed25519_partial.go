@@ -0,0 +1,153 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/coming-chat/lcs"
+)
+
+// MultiEd25519PartialSignature is the signature of one key of a MultiEd25519 account,
+// it can be transferred to the aggregator as BCS bytes by `ToBytes`.
+type MultiEd25519PartialSignature struct {
+	RawTransaction RawTransaction `lcs:"raw_txn"`
+	// The index of signer's public key in the `MultiEd25519PublicKey`
+	SignerIndex uint8            `lcs:"signer_index"`
+	Signature   Ed25519Signature `lcs:"signature"`
+}
+
+// NewMultiEd25519PartialSignature signs the transaction with the key at signerIndex of the MultiEd25519 account
+func NewMultiEd25519PartialSignature(rawTxn *RawTransaction, signerIndex uint8, signingFn SigningFunctionEd25519) (*MultiEd25519PartialSignature, error) {
+	if signingFn == nil {
+		return nil, errors.New("Signing failed: you must specify a signing function")
+	}
+	signingMessage, err := rawTxn.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := NewEd25519Signature(signingFn(signingMessage))
+	if err != nil {
+		return nil, err
+	}
+	return &MultiEd25519PartialSignature{
+		RawTransaction: *rawTxn,
+		SignerIndex:    signerIndex,
+		Signature:      *signature,
+	}, nil
+}
+
+// NewMultiEd25519PartialSignatureWithSigner signs the transaction by the ed25519 signer at signerIndex of the MultiEd25519 account
+func NewMultiEd25519PartialSignatureWithSigner(rawTxn *RawTransaction, signerIndex uint8, signer Signer) (*MultiEd25519PartialSignature, error) {
+	if signer.Scheme() != ED25519_SCHEME {
+		return nil, fmt.Errorf("Unsupported signature scheme %v.", signer.Scheme())
+	}
+	signingMessage, err := rawTxn.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	signatureBytes, err := signer.SignMessage(signingMessage)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := NewEd25519Signature(signatureBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiEd25519PartialSignature{
+		RawTransaction: *rawTxn,
+		SignerIndex:    signerIndex,
+		Signature:      *signature,
+	}, nil
+}
+
+func DecodeMultiEd25519PartialSignature(data []byte) (*MultiEd25519PartialSignature, error) {
+	partial := &MultiEd25519PartialSignature{}
+	if err := lcs.Unmarshal(data, partial); err != nil {
+		return nil, err
+	}
+	return partial, nil
+}
+
+func (p *MultiEd25519PartialSignature) ToBytes() ([]byte, error) {
+	return lcs.Marshal(p)
+}
+
+// MultiEd25519SignatureAggregator collects the partial signatures of a transaction signed by MultiEd25519 account
+type MultiEd25519SignatureAggregator struct {
+	PublicKey MultiEd25519PublicKey
+	RawTxn    RawTransaction
+
+	signingMessage SigningMessage
+	signatures     map[uint8][]byte
+}
+
+func NewMultiEd25519SignatureAggregator(publicKey MultiEd25519PublicKey, rawTxn *RawTransaction) (*MultiEd25519SignatureAggregator, error) {
+	signingMessage, err := rawTxn.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &MultiEd25519SignatureAggregator{
+		PublicKey:      publicKey,
+		RawTxn:         *rawTxn,
+		signingMessage: signingMessage,
+		signatures:     make(map[uint8][]byte),
+	}, nil
+}
+
+// Add verifies the partial signature with the public key at its signer index and keeps it
+func (a *MultiEd25519SignatureAggregator) Add(partial *MultiEd25519PartialSignature) error {
+	signingMessage, err := partial.RawTransaction.GetSigningMessage()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signingMessage, a.signingMessage) {
+		return errors.New("The partial signature is not signed for the transaction.")
+	}
+	if int(partial.SignerIndex) >= len(a.PublicKey.PublicKeys) {
+		return fmt.Errorf("Invalid signer index %v.", partial.SignerIndex)
+	}
+	publicKey := a.PublicKey.PublicKeys[partial.SignerIndex].PublicKey
+	if !ed25519.Verify(publicKey, a.signingMessage, partial.Signature.Signature) {
+		return fmt.Errorf("Invalid signature of signer %v.", partial.SignerIndex)
+	}
+	a.signatures[partial.SignerIndex] = partial.Signature.Signature
+	return nil
+}
+
+// IsComplete returns true if the number of signatures reaches the threshold
+func (a *MultiEd25519SignatureAggregator) IsComplete() bool {
+	return len(a.signatures) >= int(a.PublicKey.Threshold)
+}
+
+// Signature returns the aggregated signature, an error is returned if the threshold is not met
+func (a *MultiEd25519SignatureAggregator) Signature() (*MultiEd25519Signature, error) {
+	if !a.IsComplete() {
+		return nil, fmt.Errorf("Not enough signatures: %v of %v.", len(a.signatures), a.PublicKey.Threshold)
+	}
+	bits := []uint8{}
+	for bit := range a.signatures {
+		bits = append(bits, bit)
+	}
+	sort.Slice(bits, func(i, j int) bool { return bits[i] < bits[j] })
+	signatures := [][]byte{}
+	for _, bit := range bits {
+		signatures = append(signatures, a.signatures[bit])
+	}
+	return NewMultiEd25519Signature(signatures, bits)
+}
+
+// Build returns the BCS bytes of the signed transaction, which can be submitted
+func (a *MultiEd25519SignatureAggregator) Build() ([]byte, error) {
+	signature, err := a.Signature()
+	if err != nil {
+		return nil, err
+	}
+	builder := TransactionBuilderMultiEd25519{
+		SigningFn: func(sm SigningMessage) MultiEd25519Signature { return *signature },
+		PublicKey: a.PublicKey,
+	}
+	return builder.Sign(&a.RawTxn)
+}
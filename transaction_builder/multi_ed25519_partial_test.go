@@ -0,0 +1,76 @@
+package transactionbuilder
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiEd25519SignatureAggregator(t *testing.T) {
+	signers := []*TransactionBuilderEd25519{newTestEd25519Builder(1), newTestEd25519Builder(2), newTestEd25519Builder(3)}
+	publicKey, err := NewMultiEd25519PublicKey([][]byte{signers[0].PublicKey, signers[1].PublicKey, signers[2].PublicKey}, 2)
+	require.Nil(t, err)
+	rawTxn := &RawTransaction{
+		Sender:         publicKey.AuthenticationKey(),
+		SequenceNumber: 1,
+		Payload: TransactionPayloadEntryFunction{
+			ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "aptos_account"},
+			FunctionName: "transfer",
+			TyArgs:       []TypeTag{},
+			Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+		},
+		MaxGasAmount:            2000,
+		GasUnitPrice:            100,
+		ExpirationTimestampSecs: 1660000000,
+		ChainId:                 2,
+	}
+
+	// each signer signs on its own machine and sends the bytes
+	partialBytes := [][]byte{}
+	for i, signer := range signers {
+		partial, err := NewMultiEd25519PartialSignature(rawTxn, uint8(i), signer.SigningFn)
+		require.Nil(t, err)
+		data, err := partial.ToBytes()
+		require.Nil(t, err)
+		partialBytes = append(partialBytes, data)
+	}
+
+	aggregator, err := NewMultiEd25519SignatureAggregator(*publicKey, rawTxn)
+	require.Nil(t, err)
+
+	// signed by the key 2 but claims to be the key 0
+	wrongSigner, err := NewMultiEd25519PartialSignature(rawTxn, 0, signers[2].SigningFn)
+	require.Nil(t, err)
+	require.NotNil(t, aggregator.Add(wrongSigner))
+	// signed another transaction
+	otherTxn := *rawTxn
+	otherTxn.SequenceNumber = 2
+	otherPartial, err := NewMultiEd25519PartialSignature(&otherTxn, 0, signers[0].SigningFn)
+	require.Nil(t, err)
+	require.NotNil(t, aggregator.Add(otherPartial))
+
+	partial, err := DecodeMultiEd25519PartialSignature(partialBytes[2])
+	require.Nil(t, err)
+	require.Nil(t, aggregator.Add(partial))
+	require.False(t, aggregator.IsComplete())
+	_, err = aggregator.Build()
+	require.NotNil(t, err)
+
+	partial, err = DecodeMultiEd25519PartialSignature(partialBytes[0])
+	require.Nil(t, err)
+	require.Nil(t, aggregator.Add(partial))
+	require.True(t, aggregator.IsComplete())
+
+	data, err := aggregator.Build()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Equal(t, rawTxn, signedTxn.Transaction)
+	authenticator := signedTxn.Authenticator.(TransactionAuthenticatorMultiEd25519)
+	require.Equal(t, []byte{0b10100000, 0, 0, 0}, authenticator.Signature.Bitmap)
+	signingMessage, err := rawTxn.GetSigningMessage()
+	require.Nil(t, err)
+	require.True(t, ed25519.Verify(signers[0].PublicKey, signingMessage, authenticator.Signature.Signatures[0].Signature))
+	require.True(t, ed25519.Verify(signers[2].PublicKey, signingMessage, authenticator.Signature.Signatures[1].Signature))
+}
@@ -0,0 +1,392 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/coming-chat/lcs"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	MULTI_KEY_SCHEME = 0x3
+
+	MULTI_KEY_SIGNATURE_BITMAP_LENGTH = 4
+)
+
+// ------ MultiKey ------
+
+// MultiKey is the k-of-n public key whose keys can be any of `AnyPublicKey`, e.g. Ed25519 and Secp256k1 keys
+type MultiKey struct {
+	PublicKeys         []AnyPublicKey `lcs:"public_keys"`
+	SignaturesRequired uint8          `lcs:"signatures_required"`
+}
+
+func NewMultiKey(publicKeys []AnyPublicKey, signaturesRequired uint8) (*MultiKey, error) {
+	if len(publicKeys) > MAX_SIGNATURES_SUPPORTED {
+		return nil, fmt.Errorf("The number of public keys cannot be larger than %v.", MAX_SIGNATURES_SUPPORTED)
+	}
+	if signaturesRequired == 0 {
+		return nil, errors.New(`"signaturesRequired" must be at least 1.`)
+	}
+	if int(signaturesRequired) > len(publicKeys) {
+		return nil, errors.New(`"signaturesRequired" cannot be larger than public key count.`)
+	}
+	for _, publicKey := range publicKeys {
+		if _, err := emptyAnySignature(publicKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MultiKey{
+		PublicKeys:         publicKeys,
+		SignaturesRequired: signaturesRequired,
+	}, nil
+}
+
+// NewMultiKeyFromBytes decodes the BCS bytes of `MultiKey`
+func NewMultiKeyFromBytes(bytes []byte) (*MultiKey, error) {
+	multiKey := &MultiKey{}
+	if err := lcs.Unmarshal(bytes, multiKey); err != nil {
+		return nil, err
+	}
+	return NewMultiKey(multiKey.PublicKeys, multiKey.SignaturesRequired)
+}
+
+func (mk *MultiKey) ToBytes() ([]byte, error) {
+	return lcs.Marshal(mk)
+}
+
+// AuthenticationKey returns sha3-256(bcs(MultiKey) || MULTI_KEY_SCHEME)
+func (mk *MultiKey) AuthenticationKey() ([32]byte, error) {
+	bytes, err := mk.ToBytes()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha3.Sum256(append(bytes, MULTI_KEY_SCHEME)), nil
+}
+
+type MultiKeySignature struct {
+	Signatures []AnySignature `lcs:"signatures"`
+	Bitmap     []byte         `lcs:"bitmap"`
+}
+
+// NewMultiKeySignature sorts the signatures by bits, the i-th signature is signed by the key of bits[i]
+func NewMultiKeySignature(signatures []AnySignature, bits []uint8) (*MultiKeySignature, error) {
+	if len(signatures) != len(bits) {
+		return nil, errors.New("The number of signatures and bits are not the same.")
+	}
+	bitmap, err := CreateBitmap(bits)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]int, len(bits))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.Slice(indexes, func(i, j int) bool { return bits[indexes[i]] < bits[indexes[j]] })
+	signs := []AnySignature{}
+	for _, index := range indexes {
+		signs = append(signs, signatures[index])
+	}
+	return &MultiKeySignature{
+		Signatures: signs,
+		Bitmap:     bitmap,
+	}, nil
+}
+
+// NewMultiKeySignatureFromBytes decodes the BCS bytes of `MultiKeySignature`
+func NewMultiKeySignatureFromBytes(bytes []byte) (*MultiKeySignature, error) {
+	signature := &MultiKeySignature{}
+	if err := lcs.Unmarshal(bytes, signature); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+func (ms *MultiKeySignature) ToBytes() ([]byte, error) {
+	return lcs.Marshal(ms)
+}
+
+// verifyMultiKey checks the bitmap and the signatures required, the i-th signature is verified by the key of the i-th set bit
+func verifyMultiKey(publicKey MultiKey, signature MultiKeySignature, message []byte) error {
+	if len(signature.Bitmap) > MULTI_KEY_SIGNATURE_BITMAP_LENGTH {
+		return errors.New("Invalid multi key signature bitmap.")
+	}
+	bits := []int{}
+	for i := 0; i < len(signature.Bitmap)*8; i++ {
+		if signature.Bitmap[i/8]&(0b10000000>>(i%8)) != 0 {
+			bits = append(bits, i)
+		}
+	}
+	if len(bits) != len(signature.Signatures) {
+		return fmt.Errorf("The bitmap has %v bits set, but there are %v signatures.", len(bits), len(signature.Signatures))
+	}
+	if len(bits) < int(publicKey.SignaturesRequired) {
+		return fmt.Errorf("Not enough signatures: %v of %v.", len(bits), publicKey.SignaturesRequired)
+	}
+	for i, bit := range bits {
+		if bit >= len(publicKey.PublicKeys) {
+			return fmt.Errorf("Invalid bit %v of bitmap.", bit)
+		}
+		if err := verifyAnySignature(publicKey.PublicKeys[bit], signature.Signatures[i], message); err != nil {
+			return fmt.Errorf("Invalid signature of key %v: %v", bit, err)
+		}
+	}
+	return nil
+}
+
+// ------ MultiKeySigner ------
+
+// MultiKeySigner signs with the signers of a MultiKey account, the signers can be Ed25519 or SingleKey signers
+type MultiKeySigner struct {
+	PublicKey MultiKey
+	// The signers of the keys at the index of `PublicKey.PublicKeys`, at least `PublicKey.SignaturesRequired` signers are required
+	Signers map[uint8]Signer
+	// The address of account, the authentication key is used if it's nil
+	Address *AccountAddress
+}
+
+func NewMultiKeySigner(publicKey MultiKey, signers map[uint8]Signer) (*MultiKeySigner, error) {
+	if len(signers) < int(publicKey.SignaturesRequired) {
+		return nil, fmt.Errorf("Not enough signers: %v of %v.", len(signers), publicKey.SignaturesRequired)
+	}
+	for index, signer := range signers {
+		if int(index) >= len(publicKey.PublicKeys) {
+			return nil, fmt.Errorf("Invalid signer index %v.", index)
+		}
+		signerKey, err := signerAnyPublicKey(signer)
+		if err != nil {
+			return nil, err
+		}
+		if !equalAnyPublicKey(signerKey, publicKey.PublicKeys[index]) {
+			return nil, fmt.Errorf("The signer %v doesn't match the public key.", index)
+		}
+	}
+	signer := &MultiKeySigner{PublicKey: publicKey, Signers: signers}
+	if err := signer.checkPublicKey(); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// checkPublicKey returns error if the public key is invalid or can't be encoded,
+// the signer may be created by struct literal, so it's checked again before signing.
+func (s *MultiKeySigner) checkPublicKey() error {
+	if _, err := NewMultiKey(s.PublicKey.PublicKeys, s.PublicKey.SignaturesRequired); err != nil {
+		return err
+	}
+	_, err := s.PublicKey.AuthenticationKey()
+	return err
+}
+
+func (s *MultiKeySigner) Scheme() uint8 {
+	return MULTI_KEY_SCHEME
+}
+
+// PublicKeyBytes returns the BCS bytes of `MultiKey`, it's nil if the public key is invalid and `SignMessage` returns error
+func (s *MultiKeySigner) PublicKeyBytes() []byte {
+	bytes, _ := s.PublicKey.ToBytes()
+	return bytes
+}
+
+// AuthenticationKey returns the zero key if the public key is invalid, `SignMessage` returns error in this case
+// so no transaction of the zero address can be signed.
+func (s *MultiKeySigner) AuthenticationKey() [32]byte {
+	authKey, _ := s.PublicKey.AuthenticationKey()
+	return authKey
+}
+
+func (s *MultiKeySigner) AccountAddress() [32]byte {
+	if s.Address != nil {
+		return *s.Address
+	}
+	return s.AuthenticationKey()
+}
+
+// SignMessage signs with the first `SignaturesRequired` signers ordered by the key index, and returns the BCS bytes of `MultiKeySignature`
+func (s *MultiKeySigner) SignMessage(message []byte) ([]byte, error) {
+	if err := s.checkPublicKey(); err != nil {
+		return nil, err
+	}
+	bits := []uint8{}
+	for index := range s.Signers {
+		bits = append(bits, index)
+	}
+	sort.Slice(bits, func(i, j int) bool { return bits[i] < bits[j] })
+	if len(bits) < int(s.PublicKey.SignaturesRequired) {
+		return nil, fmt.Errorf("Not enough signers: %v of %v.", len(bits), s.PublicKey.SignaturesRequired)
+	}
+	bits = bits[:s.PublicKey.SignaturesRequired]
+	signatures := []AnySignature{}
+	for _, bit := range bits {
+		signature, err := signAnySignature(s.Signers[bit], message)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+	signature, err := NewMultiKeySignature(signatures, bits)
+	if err != nil {
+		return nil, err
+	}
+	return signature.ToBytes()
+}
+
+// signerAnyPublicKey returns the public key of Ed25519 or SingleKey signer as `AnyPublicKey`
+func signerAnyPublicKey(signer Signer) (AnyPublicKey, error) {
+	switch signer.Scheme() {
+	case ED25519_SCHEME:
+		publicKey, err := NewEd25519PublicKey(signer.PublicKeyBytes())
+		if err != nil {
+			return nil, err
+		}
+		return AnyPublicKeyEd25519{*publicKey}, nil
+	case SINGLE_KEY_SCHEME:
+		return NewAnyPublicKeyFromBytes(signer.PublicKeyBytes())
+	}
+	return nil, fmt.Errorf("Unsupported signature scheme %v.", signer.Scheme())
+}
+
+// signAnySignature signs the message by the Ed25519 or SingleKey signer and returns the signature as `AnySignature`
+func signAnySignature(signer Signer, message []byte) (AnySignature, error) {
+	signatureBytes, err := signer.SignMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	switch signer.Scheme() {
+	case ED25519_SCHEME:
+		signature, err := NewEd25519Signature(signatureBytes)
+		if err != nil {
+			return nil, err
+		}
+		return AnySignatureEd25519{*signature}, nil
+	case SINGLE_KEY_SCHEME:
+		return NewAnySignatureFromBytes(signatureBytes)
+	}
+	return nil, fmt.Errorf("Unsupported signature scheme %v.", signer.Scheme())
+}
+
+func equalAnyPublicKey(a, b AnyPublicKey) bool {
+	aBytes, err := lcs.Marshal(&a)
+	if err != nil {
+		return false
+	}
+	bBytes, err := lcs.Marshal(&b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aBytes, bBytes)
+}
+
+// ------ MultiKeySignatureAggregator ------
+
+type MultiKeyPartialSignature struct {
+	RawTransaction RawTransaction `lcs:"raw_txn"`
+	// The index of signer's public key in the `MultiKey`
+	SignerIndex uint8        `lcs:"signer_index"`
+	Signature   AnySignature `lcs:"signature"`
+}
+
+// NewMultiKeyPartialSignature signs the transaction by the Ed25519 or SingleKey signer at signerIndex of the MultiKey account
+func NewMultiKeyPartialSignature(rawTxn *RawTransaction, signerIndex uint8, signer Signer) (*MultiKeyPartialSignature, error) {
+	signingMessage, err := rawTxn.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signAnySignature(signer, signingMessage)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiKeyPartialSignature{
+		RawTransaction: *rawTxn,
+		SignerIndex:    signerIndex,
+		Signature:      signature,
+	}, nil
+}
+
+func DecodeMultiKeyPartialSignature(data []byte) (*MultiKeyPartialSignature, error) {
+	partial := &MultiKeyPartialSignature{}
+	if err := lcs.Unmarshal(data, partial); err != nil {
+		return nil, err
+	}
+	return partial, nil
+}
+
+func (p *MultiKeyPartialSignature) ToBytes() ([]byte, error) {
+	return lcs.Marshal(p)
+}
+
+// MultiKeySignatureAggregator collects the partial signatures of a transaction signed by MultiKey account
+type MultiKeySignatureAggregator struct {
+	PublicKey MultiKey
+	RawTxn    RawTransaction
+
+	signingMessage SigningMessage
+	signatures     map[uint8]AnySignature
+}
+
+func NewMultiKeySignatureAggregator(publicKey MultiKey, rawTxn *RawTransaction) (*MultiKeySignatureAggregator, error) {
+	signingMessage, err := rawTxn.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &MultiKeySignatureAggregator{
+		PublicKey:      publicKey,
+		RawTxn:         *rawTxn,
+		signingMessage: signingMessage,
+		signatures:     make(map[uint8]AnySignature),
+	}, nil
+}
+
+// Add verifies the partial signature with the public key at its signer index and keeps it
+func (a *MultiKeySignatureAggregator) Add(partial *MultiKeyPartialSignature) error {
+	signingMessage, err := partial.RawTransaction.GetSigningMessage()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signingMessage, a.signingMessage) {
+		return errors.New("The partial signature is not signed for the transaction.")
+	}
+	if int(partial.SignerIndex) >= len(a.PublicKey.PublicKeys) {
+		return fmt.Errorf("Invalid signer index %v.", partial.SignerIndex)
+	}
+	publicKey := a.PublicKey.PublicKeys[partial.SignerIndex]
+	if err := verifyAnySignature(publicKey, partial.Signature, a.signingMessage); err != nil {
+		return fmt.Errorf("Invalid signature of signer %v: %v", partial.SignerIndex, err)
+	}
+	a.signatures[partial.SignerIndex] = partial.Signature
+	return nil
+}
+
+// IsComplete returns true if the number of signatures reaches the signatures required
+func (a *MultiKeySignatureAggregator) IsComplete() bool {
+	return len(a.signatures) >= int(a.PublicKey.SignaturesRequired)
+}
+
+// Signature returns the aggregated signature, an error is returned if the signatures required is not met
+func (a *MultiKeySignatureAggregator) Signature() (*MultiKeySignature, error) {
+	if !a.IsComplete() {
+		return nil, fmt.Errorf("Not enough signatures: %v of %v.", len(a.signatures), a.PublicKey.SignaturesRequired)
+	}
+	bits := []uint8{}
+	signatures := []AnySignature{}
+	for bit, signature := range a.signatures {
+		bits = append(bits, bit)
+		signatures = append(signatures, signature)
+	}
+	return NewMultiKeySignature(signatures, bits)
+}
+
+// Build returns the BCS bytes of the signed transaction, which can be submitted
+func (a *MultiKeySignatureAggregator) Build() ([]byte, error) {
+	signature, err := a.Signature()
+	if err != nil {
+		return nil, err
+	}
+	return signedTransactionBytes(&a.RawTxn, AccountAuthenticatorMultiKey{
+		PublicKey: a.PublicKey,
+		Signature: *signature,
+	})
+}
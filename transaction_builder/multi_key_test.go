@@ -0,0 +1,150 @@
+package transactionbuilder
+
+import (
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func newTestMultiKeySigners(t *testing.T) ([]Signer, *MultiKey) {
+	secp256k1Account, err := aptosaccount.GenerateSecp256k1Account()
+	require.Nil(t, err)
+	signers := []Signer{newTestAccount(1), secp256k1Account, newTestAccount(3)}
+	publicKeys := []AnyPublicKey{}
+	for _, signer := range signers {
+		publicKey, err := signerAnyPublicKey(signer)
+		require.Nil(t, err)
+		publicKeys = append(publicKeys, publicKey)
+	}
+	multiKey, err := NewMultiKey(publicKeys, 2)
+	require.Nil(t, err)
+	return signers, multiKey
+}
+
+func TestMultiKey(t *testing.T) {
+	signers, multiKey := newTestMultiKeySigners(t)
+	_, err := NewMultiKey(multiKey.PublicKeys, 0)
+	require.NotNil(t, err)
+	_, err = NewMultiKey(multiKey.PublicKeys, 4)
+	require.NotNil(t, err)
+
+	bytes, err := multiKey.ToBytes()
+	require.Nil(t, err)
+	// 3 keys, the first is Ed25519
+	require.Equal(t, []byte{0x03, 0x00, 0x20}, bytes[:3])
+	require.Equal(t, uint8(2), bytes[len(bytes)-1])
+	decoded, err := NewMultiKeyFromBytes(bytes)
+	require.Nil(t, err)
+	require.Equal(t, multiKey, decoded)
+
+	authKey, err := multiKey.AuthenticationKey()
+	require.Nil(t, err)
+	require.Equal(t, sha3.Sum256(append(bytes, MULTI_KEY_SCHEME)), authKey)
+
+	signature, err := NewMultiKeySignature([]AnySignature{
+		AnySignatureEd25519{Ed25519Signature{make([]byte, 64)}},
+		AnySignatureSecp256k1{Secp256k1Signature{make([]byte, 64)}},
+	}, []uint8{2, 1})
+	require.Nil(t, err)
+	require.Equal(t, []byte{0x60, 0, 0, 0}, signature.Bitmap)
+	require.IsType(t, AnySignatureSecp256k1{}, signature.Signatures[0])
+
+	_, err = NewMultiKeySigner(*multiKey, map[uint8]Signer{0: signers[0], 1: signers[2]})
+	require.NotNil(t, err)
+
+	// The signer created by struct literal has the same key as the constructor
+	signer, err := NewMultiKeySigner(*multiKey, map[uint8]Signer{1: signers[1], 2: signers[2]})
+	require.Nil(t, err)
+	literal := &MultiKeySigner{PublicKey: *multiKey, Signers: signer.Signers}
+	require.Equal(t, authKey, literal.AuthenticationKey())
+	require.Equal(t, authKey, literal.AccountAddress())
+	require.Equal(t, signer.AuthenticationKey(), literal.AuthenticationKey())
+
+	// The signer with invalid public key can't sign, instead of signing for the zero address
+	for _, publicKey := range []MultiKey{
+		{PublicKeys: append([]AnyPublicKey{nil}, multiKey.PublicKeys[1:]...), SignaturesRequired: 2},
+		{PublicKeys: multiKey.PublicKeys, SignaturesRequired: 0},
+	} {
+		invalid := &MultiKeySigner{PublicKey: publicKey, Signers: signer.Signers}
+		_, err = invalid.SignMessage([]byte("message"))
+		require.NotNil(t, err)
+		rawTxn := newTestRawTransaction(invalid.AccountAddress())
+		_, err = NewTransactionBuilderSigner(invalid).Sign(rawTxn)
+		require.NotNil(t, err)
+		_, err = NewTransactionBuilderSigner(invalid).SignForSimulation(rawTxn)
+		require.NotNil(t, err)
+	}
+}
+
+func TestTransactionBuilderSigner_MultiKey(t *testing.T) {
+	signers, multiKey := newTestMultiKeySigners(t)
+	signer, err := NewMultiKeySigner(*multiKey, map[uint8]Signer{1: signers[1], 2: signers[2]})
+	require.Nil(t, err)
+	rawTxn := newTestRawTransaction(signer.AccountAddress())
+
+	data, err := GenerateBCSTransaction(signer, rawTxn)
+	require.Nil(t, err)
+	rawTxnBytes, err := lcs.Marshal(rawTxn)
+	require.Nil(t, err)
+	// SingleSender(4) → MultiKey(3)
+	require.Equal(t, []byte{0x04, 0x03}, data[len(rawTxnBytes):len(rawTxnBytes)+2])
+
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	authKey := signer.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+	auth := signedTxn.Authenticator.(TransactionAuthenticatorSingleSender).Sender.(AccountAuthenticatorMultiKey)
+	require.Equal(t, []byte{0x60, 0, 0, 0}, auth.Signature.Bitmap)
+
+	auth.Signature.Bitmap = []byte{0x40, 0, 0, 0}
+	signedTxn.Authenticator = TransactionAuthenticatorSingleSender{Sender: auth}
+	require.NotNil(t, signedTxn.Verify(nil))
+
+	simulation, err := NewTransactionBuilderSigner(signer).SignForSimulation(rawTxn)
+	require.Nil(t, err)
+	signedTxn, err = DecodeSignedTransaction(simulation)
+	require.Nil(t, err)
+	require.NotNil(t, signedTxn.Verify(nil))
+}
+
+func TestMultiKeySignatureAggregator(t *testing.T) {
+	signers, multiKey := newTestMultiKeySigners(t)
+	authKey, err := multiKey.AuthenticationKey()
+	require.Nil(t, err)
+	rawTxn := newTestRawTransaction(authKey)
+
+	// each signer signs on its own machine and sends the bytes
+	partialBytes := [][]byte{}
+	for i, signer := range signers {
+		partial, err := NewMultiKeyPartialSignature(rawTxn, uint8(i), signer)
+		require.Nil(t, err)
+		data, err := partial.ToBytes()
+		require.Nil(t, err)
+		partialBytes = append(partialBytes, data)
+	}
+
+	aggregator, err := NewMultiKeySignatureAggregator(*multiKey, rawTxn)
+	require.Nil(t, err)
+	_, err = aggregator.Build()
+	require.NotNil(t, err)
+
+	wrongIndex, err := DecodeMultiKeyPartialSignature(partialBytes[0])
+	require.Nil(t, err)
+	wrongIndex.SignerIndex = 1
+	require.NotNil(t, aggregator.Add(wrongIndex))
+
+	for _, i := range []int{2, 1} {
+		partial, err := DecodeMultiKeyPartialSignature(partialBytes[i])
+		require.Nil(t, err)
+		require.Nil(t, aggregator.Add(partial))
+	}
+	require.True(t, aggregator.IsComplete())
+	data, err := aggregator.Build()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+}
@@ -0,0 +1,115 @@
+package transactionbuilder
+
+import (
+	"errors"
+
+	"github.com/coming-chat/lcs"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	MULTISIG_ACCOUNT_DOMAIN_SEPARATOR = "aptos_framework::multisig_account"
+	DERIVE_RESOURCE_ACCOUNT_SCHEME    = 0xFF
+)
+
+var multisigAccountModule = ModuleId{Address: AccountAddress{31: 1}, Name: "multisig_account"}
+
+// CreateResourceAddress returns the address of resource account created by source with seed, as `0x1::account::create_resource_address`
+func CreateResourceAddress(source AccountAddress, seed []byte) AccountAddress {
+	data := append(append(source[:], seed...), DERIVE_RESOURCE_ACCOUNT_SCHEME)
+	return sha3.Sum256(data)
+}
+
+/**
+ * Returns the address of the multisig account that will be created by creator,
+ * as `0x1::multisig_account::get_next_multisig_account_address`.
+ * @param creatorSequenceNumber The sequence number of creator's account when the multisig account is created.
+ */
+func MultisigAccountAddress(creator AccountAddress, creatorSequenceNumber uint64) AccountAddress {
+	seed := append([]byte(MULTISIG_ACCOUNT_DOMAIN_SEPARATOR), BCSSerializeBasicValue(creatorSequenceNumber)...)
+	return CreateResourceAddress(creator, seed)
+}
+
+/**
+ * The payload of `0x1::multisig_account::create_with_owners`, the sender will be an owner of the created multisig account,
+ * whose address is `MultisigAccountAddress(sender, sequenceNumber)`.
+ */
+func NewMultisigCreateWithOwnersPayload(additionalOwners []AccountAddress, numSignaturesRequired uint64, metadataKeys []string, metadataValues [][]byte) (*TransactionPayloadEntryFunction, error) {
+	if len(metadataKeys) != len(metadataValues) {
+		return nil, errors.New("The number of metadata keys and values are not the same.")
+	}
+	if additionalOwners == nil {
+		additionalOwners = []AccountAddress{}
+	}
+	if metadataKeys == nil {
+		metadataKeys = []string{}
+	}
+	if metadataValues == nil {
+		metadataValues = [][]byte{}
+	}
+	args := [][]byte{}
+	for _, arg := range []any{additionalOwners, numSignaturesRequired, metadataKeys, metadataValues} {
+		bytes, err := lcs.Marshal(arg)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, bytes)
+	}
+	return &TransactionPayloadEntryFunction{
+		ModuleName:   multisigAccountModule,
+		FunctionName: "create_with_owners",
+		TyArgs:       []TypeTag{},
+		Args:         args,
+	}, nil
+}
+
+// The payload of `0x1::multisig_account::create_transaction`, an owner proposes the transaction of the multisig account
+func NewMultisigCreateTransactionPayload(multisigAddress AccountAddress, payload *TransactionPayloadEntryFunction) (*TransactionPayloadEntryFunction, error) {
+	var multisigPayload MultisigTransactionPayload = *payload
+	payloadBytes, err := lcs.Marshal(&multisigPayload)
+	if err != nil {
+		return nil, err
+	}
+	payloadArg, err := lcs.Marshal(payloadBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionPayloadEntryFunction{
+		ModuleName:   multisigAccountModule,
+		FunctionName: "create_transaction",
+		TyArgs:       []TypeTag{},
+		Args:         [][]byte{multisigAddress[:], payloadArg},
+	}, nil
+}
+
+// The payload of `0x1::multisig_account::approve_transaction`
+func NewMultisigApproveTransactionPayload(multisigAddress AccountAddress, sequenceNumber uint64) *TransactionPayloadEntryFunction {
+	return newMultisigVotePayload("approve_transaction", multisigAddress, sequenceNumber)
+}
+
+// The payload of `0x1::multisig_account::reject_transaction`
+func NewMultisigRejectTransactionPayload(multisigAddress AccountAddress, sequenceNumber uint64) *TransactionPayloadEntryFunction {
+	return newMultisigVotePayload("reject_transaction", multisigAddress, sequenceNumber)
+}
+
+func newMultisigVotePayload(function Identifier, multisigAddress AccountAddress, sequenceNumber uint64) *TransactionPayloadEntryFunction {
+	return &TransactionPayloadEntryFunction{
+		ModuleName:   multisigAccountModule,
+		FunctionName: function,
+		TyArgs:       []TypeTag{},
+		Args:         [][]byte{multisigAddress[:], BCSSerializeBasicValue(sequenceNumber)},
+	}
+}
+
+/**
+ * The payload to execute the next transaction of multisig account after it has enough approvals,
+ * the transaction can be sent by any owner.
+ * @param payload The entry function of the multisig transaction, it can be nil if the full payload is stored on chain.
+ */
+func NewMultisigTransactionPayload(multisigAddress AccountAddress, payload *TransactionPayloadEntryFunction) TransactionPayloadMultisig {
+	res := TransactionPayloadMultisig{MultisigAddress: multisigAddress}
+	if payload != nil {
+		res.TransactionPayload = *payload
+	}
+	return res
+}
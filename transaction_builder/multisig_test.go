@@ -0,0 +1,75 @@
+package transactionbuilder
+
+import (
+	"testing"
+
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestTransactionPayloadMultisig(t *testing.T) {
+	multisigAddress := *AccountAddressFromHex("0xabc")
+	entryFunction := &TransactionPayloadEntryFunction{
+		ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "aptos_account"},
+		FunctionName: "transfer",
+		TyArgs:       []TypeTag{},
+		Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+	}
+	entryFunctionBytes, err := lcs.Marshal(entryFunction)
+	require.Nil(t, err)
+
+	for _, payload := range []*TransactionPayloadEntryFunction{entryFunction, nil} {
+		var multisig TransactionPayload = NewMultisigTransactionPayload(multisigAddress, payload)
+		data, err := lcs.Marshal(&multisig)
+		require.Nil(t, err)
+
+		expected := append([]byte{3}, multisigAddress[:]...)
+		if payload == nil {
+			expected = append(expected, 0)
+		} else {
+			expected = append(append(expected, 1, 0), entryFunctionBytes...)
+		}
+		require.Equal(t, expected, data)
+
+		var decoded TransactionPayload
+		require.Nil(t, lcs.Unmarshal(data, &decoded))
+		require.Equal(t, multisig, decoded)
+	}
+}
+
+func TestMultisigAccountPayloads(t *testing.T) {
+	creator := *AccountAddressFromHex("0x1234")
+	seed := append([]byte("aptos_framework::multisig_account"), 5, 0, 0, 0, 0, 0, 0, 0)
+	expected := sha3.Sum256(append(append(creator[:], seed...), 0xFF))
+	require.Equal(t, AccountAddress(expected), MultisigAccountAddress(creator, 5))
+
+	payload, err := NewMultisigCreateWithOwnersPayload([]AccountAddress{*AccountAddressFromHex("0x2")}, 2, []string{"name"}, [][]byte{[]byte("team")})
+	require.Nil(t, err)
+	require.Equal(t, "0x1::multisig_account", payload.ModuleName.Address.ToShortString()+"::"+string(payload.ModuleName.Name))
+	require.Equal(t, Identifier("create_with_owners"), payload.FunctionName)
+	require.Equal(t, [][]byte{
+		append([]byte{1}, AccountAddressFromHex("0x2")[:]...),
+		{2, 0, 0, 0, 0, 0, 0, 0},
+		{1, 4, 'n', 'a', 'm', 'e'},
+		{1, 4, 't', 'e', 'a', 'm'},
+	}, payload.Args)
+	_, err = NewMultisigCreateWithOwnersPayload(nil, 1, []string{"name"}, nil)
+	require.NotNil(t, err)
+
+	multisigAddress := MultisigAccountAddress(creator, 5)
+	createTxn, err := NewMultisigCreateTransactionPayload(multisigAddress, payload)
+	require.Nil(t, err)
+	require.Equal(t, multisigAddress[:], createTxn.Args[0])
+	var payloadBytes []byte
+	require.Nil(t, lcs.Unmarshal(createTxn.Args[1], &payloadBytes))
+	var multisigPayload MultisigTransactionPayload
+	require.Nil(t, lcs.Unmarshal(payloadBytes, &multisigPayload))
+	require.Equal(t, *payload, multisigPayload)
+
+	approve := NewMultisigApproveTransactionPayload(multisigAddress, 3)
+	require.Equal(t, Identifier("approve_transaction"), approve.FunctionName)
+	require.Equal(t, [][]byte{multisigAddress[:], {3, 0, 0, 0, 0, 0, 0, 0}}, approve.Args)
+	reject := NewMultisigRejectTransactionPayload(multisigAddress, 3)
+	require.Equal(t, Identifier("reject_transaction"), reject.FunctionName)
+}
@@ -15,6 +15,7 @@ type RemoteModuleFetcher interface {
 // ------ TransactionBuilderRemoteABI ------
 type TransactionBuilderRemoteABI struct {
 	EntryFunctions map[string]aptostypes.MoveFunction
+	ViewFunctions  map[string]aptostypes.MoveFunction
 }
 
 func NewTransactionBuilderRemoteABI(contractAddress, moduleName string, fetcher RemoteModuleFetcher) (*TransactionBuilderRemoteABI, error) {
@@ -35,8 +36,12 @@ func NewTransactionBuilderRemoteABI(contractAddress, moduleName string, fetcher
 		return nil, err
 	}
 	functions := make(map[string]aptostypes.MoveFunction)
+	viewFunctions := make(map[string]aptostypes.MoveFunction)
 	abiName := module.Abi.Address + "::" + module.Abi.Name
 	for _, function := range module.Abi.ExposedFunctions {
+		if function.IsView {
+			viewFunctions[abiName+"::"+function.Name] = function
+		}
 		if !function.IsEntry {
 			continue
 		}
@@ -45,6 +50,7 @@ func NewTransactionBuilderRemoteABI(contractAddress, moduleName string, fetcher
 
 	return &TransactionBuilderRemoteABI{
 		EntryFunctions: functions,
+		ViewFunctions:  viewFunctions,
 	}, nil
 }
 
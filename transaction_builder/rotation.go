@@ -0,0 +1,82 @@
+package transactionbuilder
+
+import (
+	"fmt"
+
+	"github.com/coming-chat/lcs"
+)
+
+var accountModule = ModuleId{Address: AccountAddress{31: 1}, Name: "account"}
+
+/**
+ * RotationProofChallenge is the message signed by both the current key and the new key to rotate the authentication key,
+ * it's the BCS of `0x1::account::RotationProofChallenge` prefixed by its type info, as `signature_verify_strict_t` of Move.
+ */
+type RotationProofChallenge struct {
+	AccountAddress AccountAddress `lcs:"account_address"`
+	ModuleName     string         `lcs:"module_name"`
+	StructName     string         `lcs:"struct_name"`
+	// The sequence number of the account whose key is being rotated
+	SequenceNumber uint64 `lcs:"sequence_number"`
+	// The address of the account whose key is being rotated
+	Originator     AccountAddress `lcs:"originator"`
+	CurrentAuthKey AccountAddress `lcs:"current_auth_key"`
+	NewPublicKey   []byte         `lcs:"new_public_key"`
+}
+
+func NewRotationProofChallenge(sequenceNumber uint64, originator, currentAuthKey AccountAddress, newPublicKey []byte) *RotationProofChallenge {
+	return &RotationProofChallenge{
+		AccountAddress: accountModule.Address,
+		ModuleName:     string(accountModule.Name),
+		StructName:     "RotationProofChallenge",
+		SequenceNumber: sequenceNumber,
+		Originator:     originator,
+		CurrentAuthKey: currentAuthKey,
+		NewPublicKey:   newPublicKey,
+	}
+}
+
+func (c *RotationProofChallenge) ToBytes() ([]byte, error) {
+	return lcs.Marshal(c)
+}
+
+/**
+ * The payload of `0x1::account::rotate_authentication_key`, the challenge is signed by both the current and the new signers.
+ * After the rotation, the account should be signed by the new key with the original address, e.g. `aptosaccount.AccountWithAddress`.
+ * @param sequenceNumber The sequence number of the account when the rotation transaction is executed
+ * @param from The signer of current key, its `AccountAddress` must be the address of the account
+ * @param to The signer of new key, only Ed25519 and MultiEd25519 signers are supported
+ */
+func NewRotateAuthenticationKeyPayload(sequenceNumber uint64, from, to Signer) (*TransactionPayloadEntryFunction, error) {
+	for _, signer := range []Signer{from, to} {
+		if signer.Scheme() != ED25519_SCHEME && signer.Scheme() != MULTI_ED25519_SCHEME {
+			return nil, fmt.Errorf("Unsupported signature scheme %v of key rotation.", signer.Scheme())
+		}
+	}
+	challenge, err := NewRotationProofChallenge(sequenceNumber, from.AccountAddress(), from.AuthenticationKey(), to.PublicKeyBytes()).ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	capRotateKey, err := from.SignMessage(challenge)
+	if err != nil {
+		return nil, err
+	}
+	capUpdateTable, err := to.SignMessage(challenge)
+	if err != nil {
+		return nil, err
+	}
+	args := [][]byte{}
+	for _, arg := range []any{from.Scheme(), from.PublicKeyBytes(), to.Scheme(), to.PublicKeyBytes(), capRotateKey, capUpdateTable} {
+		bytes, err := lcs.Marshal(arg)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, bytes)
+	}
+	return &TransactionPayloadEntryFunction{
+		ModuleName:   accountModule,
+		FunctionName: "rotate_authentication_key",
+		TyArgs:       []TypeTag{},
+		Args:         args,
+	}, nil
+}
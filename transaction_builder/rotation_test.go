@@ -0,0 +1,86 @@
+package transactionbuilder
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationProofChallenge(t *testing.T) {
+	originator := *AccountAddressFromHex("0x1234")
+	challenge, err := NewRotationProofChallenge(5, originator, originator, []byte{0xab}).ToBytes()
+	require.Nil(t, err)
+	one := AccountAddress{31: 1}
+	expected := append(one[:], 7)
+	expected = append(append(expected, "account"...), 22)
+	expected = append(append(expected, "RotationProofChallenge"...), BCSSerializeBasicValue(uint64(5))...)
+	expected = append(append(expected, originator[:]...), originator[:]...)
+	expected = append(expected, 1, 0xab)
+	require.Equal(t, expected, challenge)
+}
+
+func TestNewRotateAuthenticationKeyPayload(t *testing.T) {
+	from := newTestAccount(1)
+	to := newTestAccount(2)
+	payload, err := NewRotateAuthenticationKeyPayload(3, from, to)
+	require.Nil(t, err)
+	require.Equal(t, Identifier("rotate_authentication_key"), payload.FunctionName)
+	require.Len(t, payload.Args, 6)
+	require.Equal(t, []byte{ED25519_SCHEME}, payload.Args[0])
+	require.Equal(t, append([]byte{32}, to.PublicKey...), payload.Args[3])
+
+	challenge, err := NewRotationProofChallenge(3, from.AuthKey, from.AuthKey, to.PublicKey).ToBytes()
+	require.Nil(t, err)
+	for i, key := range []ed25519.PublicKey{from.PublicKey, to.PublicKey} {
+		signature := []byte{}
+		require.Nil(t, lcs.Unmarshal(payload.Args[4+i], &signature))
+		require.True(t, ed25519.Verify(key, challenge, signature))
+	}
+
+	secp256k1Account, err := aptosaccount.GenerateSecp256k1Account()
+	require.Nil(t, err)
+	_, err = NewRotateAuthenticationKeyPayload(3, from, secp256k1Account)
+	require.NotNil(t, err)
+}
+
+func TestRotateAuthenticationKey_MultiEd25519(t *testing.T) {
+	from := newTestAccount(1)
+	accounts := []*aptosaccount.Account{newTestAccount(2), newTestAccount(3)}
+	publicKey, err := NewMultiEd25519PublicKey([][]byte{accounts[0].PublicKey, accounts[1].PublicKey}, 2)
+	require.Nil(t, err)
+	to, err := NewMultiEd25519Signer(*publicKey, map[uint8]Signer{0: accounts[0], 1: accounts[1]})
+	require.Nil(t, err)
+
+	payload, err := NewRotateAuthenticationKeyPayload(3, from, to)
+	require.Nil(t, err)
+	require.Equal(t, []byte{MULTI_ED25519_SCHEME}, payload.Args[2])
+
+	// the new key signs the transactions of the original address after rotation
+	originalAddress := AccountAddress(from.AuthKey)
+	to.Address = &originalAddress
+	data, err := GenerateBCSTransaction(to, newTestRawTransaction(to.AccountAddress()))
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Equal(t, AccountAddress(from.AuthKey), signedTxn.Transaction.Sender)
+	authKey := publicKey.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+}
+
+func TestAccountWithAddress(t *testing.T) {
+	original := newTestAccount(1)
+	rotated := aptosaccount.NewAccountWithAddress(newTestAccount(2), original.AuthKey)
+	require.Equal(t, original.AuthKey, rotated.AccountAddress())
+	require.NotEqual(t, rotated.AuthenticationKey(), rotated.AccountAddress())
+
+	data, err := NewTransactionBuilderSigner(rotated).Sign(newTestRawTransaction(rotated.AccountAddress()))
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Equal(t, AccountAddress(original.AuthKey), signedTxn.Transaction.Sender)
+	authKey := rotated.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+}
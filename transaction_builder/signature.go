@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/coming-chat/go-aptos/aptosaccount"
 	"github.com/coming-chat/lcs"
 	"golang.org/x/crypto/sha3"
 )
@@ -18,6 +19,7 @@ const (
 
 	MULTI_ED25519_SIGNATURE_BITMAP_LENGTH = 4
 
+	ED25519_SCHEME       = aptosaccount.Ed25519Scheme
 	MULTI_ED25519_SCHEME = 0x1
 )
 
@@ -37,6 +39,11 @@ func NewEd25519PublicKey(publicKey []byte) (*Ed25519PublicKey, error) {
 	return &Ed25519PublicKey{publicKey}, nil
 }
 
+func (p *Ed25519PublicKey) AuthenticationKey() [32]byte {
+	bytes := append(append([]byte{}, p.PublicKey...), ED25519_SCHEME)
+	return sha3.Sum256(bytes)
+}
+
 type Ed25519Signature struct {
 	Signature []byte `lcs:"signature"`
 }
@@ -94,13 +101,28 @@ func (mp *MultiEd25519PublicKey) UnmarshalLCS(d *lcs.Decoder) error {
 	if err != nil {
 		return err
 	}
-	mp.Threshold = bytes[len(bytes)-1]
-	mp.PublicKeys = []Ed25519PublicKey{}
+	publicKey, err := NewMultiEd25519PublicKeyFromBytes(bytes)
+	if err != nil {
+		return err
+	}
+	*mp = *publicKey
+	return nil
+}
+
+// NewMultiEd25519PublicKeyFromBytes is the reverse of `ToBytes`
+func NewMultiEd25519PublicKeyFromBytes(bytes []byte) (*MultiEd25519PublicKey, error) {
+	if len(bytes) == 0 || (len(bytes)-1)%ED25519_PUBLICKEY_LENGTH != 0 {
+		return nil, errors.New("Invalid MultiEd25519PublicKey bytes.")
+	}
+	mp := &MultiEd25519PublicKey{
+		PublicKeys: []Ed25519PublicKey{},
+		Threshold:  bytes[len(bytes)-1],
+	}
 	for i := 0; i < len(bytes)-1; i += ED25519_PUBLICKEY_LENGTH {
 		publicBytes := bytes[i : i+ED25519_PUBLICKEY_LENGTH]
 		mp.PublicKeys = append(mp.PublicKeys, Ed25519PublicKey{publicBytes})
 	}
-	return nil
+	return mp, nil
 }
 
 func (mp *MultiEd25519PublicKey) AuthenticationKey() [32]byte {
@@ -162,13 +184,28 @@ func (ms *MultiEd25519Signature) UnmarshalLCS(d *lcs.Decoder) error {
 	if err != nil {
 		return err
 	}
-	ms.Bitmap = bytes[len(bytes)-MULTI_ED25519_SIGNATURE_BITMAP_LENGTH:]
-	ms.Signatures = []Ed25519Signature{}
+	signature, err := NewMultiEd25519SignatureFromBytes(bytes)
+	if err != nil {
+		return err
+	}
+	*ms = *signature
+	return nil
+}
+
+// NewMultiEd25519SignatureFromBytes is the reverse of `ToBytes`
+func NewMultiEd25519SignatureFromBytes(bytes []byte) (*MultiEd25519Signature, error) {
+	if len(bytes) < MULTI_ED25519_SIGNATURE_BITMAP_LENGTH || (len(bytes)-MULTI_ED25519_SIGNATURE_BITMAP_LENGTH)%ED25519_SIGNATURE_LENGTH != 0 {
+		return nil, errors.New("Invalid MultiEd25519Signature bytes.")
+	}
+	ms := &MultiEd25519Signature{
+		Signatures: []Ed25519Signature{},
+		Bitmap:     bytes[len(bytes)-MULTI_ED25519_SIGNATURE_BITMAP_LENGTH:],
+	}
 	for i := 0; i < len(bytes)-MULTI_ED25519_SIGNATURE_BITMAP_LENGTH; i += ED25519_SIGNATURE_LENGTH {
 		signatureBytes := bytes[i : i+ED25519_SIGNATURE_LENGTH]
 		ms.Signatures = append(ms.Signatures, Ed25519Signature{signatureBytes})
 	}
-	return nil
+	return ms, nil
 }
 
 /**
@@ -0,0 +1,285 @@
+package transactionbuilder
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/coming-chat/lcs"
+	"golang.org/x/crypto/sha3"
+)
+
+/**
+ * Signer signs the transactions with any key storage, e.g. local private key, KMS, HSM or remote signing service.
+ * It's implemented by `aptosaccount.Account`, `aptosaccount.Secp256k1Account`, `MultiEd25519Signer`, `MultiKeySigner` and `RemoteSigner`,
+ * and can be used to sign transactions by `TransactionBuilderSigner`.
+ */
+type Signer interface {
+	// The signature scheme, `ED25519_SCHEME`, `MULTI_ED25519_SCHEME`, `SINGLE_KEY_SCHEME` or `MULTI_KEY_SCHEME`
+	Scheme() uint8
+	// The bytes of public key, it's `MultiEd25519PublicKey.ToBytes()` for MultiEd25519, the BCS bytes of `AnyPublicKey` for SingleKey,
+	// and the BCS bytes of `MultiKey` for MultiKey
+	PublicKeyBytes() []byte
+	AuthenticationKey() [32]byte
+	// The address of account, it's different from the authentication key if the key has been rotated
+	AccountAddress() [32]byte
+	// SignMessage signs the signing message, the signature of MultiEd25519 is `MultiEd25519Signature.ToBytes()`,
+	// the signature of SingleKey is the BCS bytes of `AnySignature`, and the signature of MultiKey is the BCS bytes of `MultiKeySignature`
+	SignMessage(message []byte) ([]byte, error)
+}
+
+// SignAccountAuthenticator signs the message, e.g. `RawTransaction` or `FeePayerRawTransaction`, and returns the authenticator of signer
+func SignAccountAuthenticator(signer Signer, signable Signable) (AccountAuthenticator, error) {
+	signingMessage, err := signable.GetSigningMessage()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.SignMessage(signingMessage)
+	if err != nil {
+		return nil, err
+	}
+	return newAccountAuthenticator(signer.Scheme(), signer.PublicKeyBytes(), signature)
+}
+
+func newAccountAuthenticator(scheme uint8, publicKeyBytes, signatureBytes []byte) (AccountAuthenticator, error) {
+	switch scheme {
+	case ED25519_SCHEME:
+		publicKey, err := NewEd25519PublicKey(publicKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := NewEd25519Signature(signatureBytes)
+		if err != nil {
+			return nil, err
+		}
+		return AccountAuthenticatorEd25519{PublicKey: *publicKey, Signature: *signature}, nil
+	case MULTI_ED25519_SCHEME:
+		publicKey, err := NewMultiEd25519PublicKeyFromBytes(publicKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := NewMultiEd25519SignatureFromBytes(signatureBytes)
+		if err != nil {
+			return nil, err
+		}
+		return AccountAuthenticatorMultiEd25519{PublicKey: *publicKey, Signature: *signature}, nil
+	case SINGLE_KEY_SCHEME:
+		publicKey, err := NewAnyPublicKeyFromBytes(publicKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := NewAnySignatureFromBytes(signatureBytes)
+		if err != nil {
+			return nil, err
+		}
+		return AccountAuthenticatorSingleKey{PublicKey: publicKey, Signature: signature}, nil
+	case MULTI_KEY_SCHEME:
+		publicKey, err := NewMultiKeyFromBytes(publicKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := NewMultiKeySignatureFromBytes(signatureBytes)
+		if err != nil {
+			return nil, err
+		}
+		return AccountAuthenticatorMultiKey{PublicKey: *publicKey, Signature: *signature}, nil
+	}
+	return nil, fmt.Errorf("Unsupported signature scheme %v.", scheme)
+}
+
+// ------ TransactionBuilderSigner ------
+
+// TransactionBuilderSigner signs transactions with the `Signer`, it can be used by `TransactionFactory` and `GasEstimator`.
+type TransactionBuilderSigner struct {
+	Signer Signer
+}
+
+func NewTransactionBuilderSigner(signer Signer) *TransactionBuilderSigner {
+	return &TransactionBuilderSigner{Signer: signer}
+}
+
+func (b *TransactionBuilderSigner) Sign(rawTxn *RawTransaction) ([]byte, error) {
+	authenticator, err := b.SignAccountAuthenticator(rawTxn)
+	if err != nil {
+		return nil, err
+	}
+	return signedTransactionBytes(rawTxn, authenticator)
+}
+
+// signedTransactionBytes wraps the authenticator of sender to the transaction authenticator and returns the BCS bytes of signed transaction
+func signedTransactionBytes(rawTxn *RawTransaction, authenticator AccountAuthenticator) ([]byte, error) {
+	signedTxn := SignedTransaction{Transaction: rawTxn}
+	switch auth := authenticator.(type) {
+	case AccountAuthenticatorEd25519:
+		signedTxn.Authenticator = TransactionAuthenticatorEd25519(auth)
+	case AccountAuthenticatorMultiEd25519:
+		signedTxn.Authenticator = TransactionAuthenticatorMultiEd25519(auth)
+	case AccountAuthenticatorSingleKey, AccountAuthenticatorMultiKey:
+		signedTxn.Authenticator = TransactionAuthenticatorSingleSender{Sender: auth}
+	default:
+		return nil, fmt.Errorf("Unsupported account authenticator %T.", authenticator)
+	}
+	return lcs.Marshal(signedTxn)
+}
+
+func (b *TransactionBuilderSigner) SignAccountAuthenticator(signable Signable) (AccountAuthenticator, error) {
+	return SignAccountAuthenticator(b.Signer, signable)
+}
+
+// SignForSimulation signs the transaction with invalid signatures, which can only be used to simulate the transaction
+func (b *TransactionBuilderSigner) SignForSimulation(rawTxn *RawTransaction) ([]byte, error) {
+	switch b.Signer.Scheme() {
+	case ED25519_SCHEME:
+		return GenerateBCSSimulation(b.Signer.PublicKeyBytes(), rawTxn)
+	case MULTI_ED25519_SCHEME:
+		publicKey, err := NewMultiEd25519PublicKeyFromBytes(b.Signer.PublicKeyBytes())
+		if err != nil {
+			return nil, err
+		}
+		builder := TransactionBuilderMultiEd25519{PublicKey: *publicKey}
+		return builder.SignForSimulation(rawTxn)
+	case SINGLE_KEY_SCHEME:
+		publicKey, err := NewAnyPublicKeyFromBytes(b.Signer.PublicKeyBytes())
+		if err != nil {
+			return nil, err
+		}
+		signature, err := emptyAnySignature(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		return signedTransactionBytes(rawTxn, AccountAuthenticatorSingleKey{PublicKey: publicKey, Signature: signature})
+	case MULTI_KEY_SCHEME:
+		publicKey, err := NewMultiKeyFromBytes(b.Signer.PublicKeyBytes())
+		if err != nil {
+			return nil, err
+		}
+		bits := []uint8{}
+		signatures := []AnySignature{}
+		for i := uint8(0); i < publicKey.SignaturesRequired; i++ {
+			signature, err := emptyAnySignature(publicKey.PublicKeys[i])
+			if err != nil {
+				return nil, err
+			}
+			bits = append(bits, i)
+			signatures = append(signatures, signature)
+		}
+		signature, err := NewMultiKeySignature(signatures, bits)
+		if err != nil {
+			return nil, err
+		}
+		return signedTransactionBytes(rawTxn, AccountAuthenticatorMultiKey{PublicKey: *publicKey, Signature: *signature})
+	}
+	return nil, fmt.Errorf("Unsupported signature scheme %v.", b.Signer.Scheme())
+}
+
+// ------ MultiEd25519Signer ------
+
+// MultiEd25519Signer signs with the ed25519 signers of a MultiEd25519 account
+type MultiEd25519Signer struct {
+	PublicKey MultiEd25519PublicKey
+	// The signers of the keys at the index of `PublicKey.PublicKeys`, at least `PublicKey.Threshold` signers are required
+	Signers map[uint8]Signer
+	// The address of account, the authentication key is used if it's nil
+	Address *AccountAddress
+}
+
+func NewMultiEd25519Signer(publicKey MultiEd25519PublicKey, signers map[uint8]Signer) (*MultiEd25519Signer, error) {
+	if len(signers) < int(publicKey.Threshold) {
+		return nil, fmt.Errorf("Not enough signers: %v of %v.", len(signers), publicKey.Threshold)
+	}
+	for index := range signers {
+		if int(index) >= len(publicKey.PublicKeys) {
+			return nil, fmt.Errorf("Invalid signer index %v.", index)
+		}
+	}
+	return &MultiEd25519Signer{PublicKey: publicKey, Signers: signers}, nil
+}
+
+func (s *MultiEd25519Signer) Scheme() uint8 {
+	return MULTI_ED25519_SCHEME
+}
+
+func (s *MultiEd25519Signer) PublicKeyBytes() []byte {
+	return s.PublicKey.ToBytes()
+}
+
+func (s *MultiEd25519Signer) AuthenticationKey() [32]byte {
+	return s.PublicKey.AuthenticationKey()
+}
+
+func (s *MultiEd25519Signer) AccountAddress() [32]byte {
+	if s.Address != nil {
+		return *s.Address
+	}
+	return s.AuthenticationKey()
+}
+
+// SignMessage signs with the first `Threshold` signers ordered by the key index
+func (s *MultiEd25519Signer) SignMessage(message []byte) ([]byte, error) {
+	bits := []uint8{}
+	for index := range s.Signers {
+		bits = append(bits, index)
+	}
+	sort.Slice(bits, func(i, j int) bool { return bits[i] < bits[j] })
+	if len(bits) < int(s.PublicKey.Threshold) {
+		return nil, fmt.Errorf("Not enough signers: %v of %v.", len(bits), s.PublicKey.Threshold)
+	}
+	bits = bits[:s.PublicKey.Threshold]
+	signatures := [][]byte{}
+	for _, bit := range bits {
+		signature, err := s.Signers[bit].SignMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+	signature, err := NewMultiEd25519Signature(signatures, bits)
+	if err != nil {
+		return nil, err
+	}
+	return signature.ToBytes(), nil
+}
+
+// ------ RemoteSigner ------
+
+// RemoteSigningFunction signs the message remotely, e.g. by KMS, HSM or a signing service
+type RemoteSigningFunction func(message []byte) ([]byte, error)
+
+// RemoteSigner adapts a remote signing function whose key is not accessible locally
+type RemoteSigner struct {
+	SignatureScheme uint8
+	PublicKey       []byte
+	SigningFn       RemoteSigningFunction
+	// The address of account, the authentication key is used if it's nil
+	Address *AccountAddress
+}
+
+func NewRemoteSigner(scheme uint8, publicKey []byte, signingFn RemoteSigningFunction) *RemoteSigner {
+	return &RemoteSigner{SignatureScheme: scheme, PublicKey: publicKey, SigningFn: signingFn}
+}
+
+func (s *RemoteSigner) Scheme() uint8 {
+	return s.SignatureScheme
+}
+
+func (s *RemoteSigner) PublicKeyBytes() []byte {
+	return s.PublicKey
+}
+
+func (s *RemoteSigner) AuthenticationKey() [32]byte {
+	return sha3.Sum256(append(append([]byte{}, s.PublicKey...), s.SignatureScheme))
+}
+
+func (s *RemoteSigner) AccountAddress() [32]byte {
+	if s.Address != nil {
+		return *s.Address
+	}
+	return s.AuthenticationKey()
+}
+
+func (s *RemoteSigner) SignMessage(message []byte) ([]byte, error) {
+	if s.SigningFn == nil {
+		return nil, errors.New("Signing failed: you must specify a signing function")
+	}
+	return s.SigningFn(message)
+}
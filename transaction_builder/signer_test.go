@@ -0,0 +1,101 @@
+package transactionbuilder
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAccount(seed byte) *aptosaccount.Account {
+	privateKey := make([]byte, 32)
+	privateKey[0] = seed
+	return aptosaccount.NewAccount(privateKey)
+}
+
+func TestTransactionBuilderSigner_Account(t *testing.T) {
+	var _ Signer = &aptosaccount.Account{}
+	account := newTestAccount(1)
+	rawTxn := newTestRawTransaction(account.AccountAddress())
+
+	data, err := GenerateBCSTransaction(account, rawTxn)
+	require.Nil(t, err)
+	expected, err := NewTransactionBuilderEd25519(func(sm SigningMessage) []byte {
+		return account.Sign(sm, "")
+	}, account.PublicKey).Sign(rawTxn)
+	require.Nil(t, err)
+	require.Equal(t, expected, data)
+
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	authKey := account.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+
+	_, err = NewTransactionBuilderSigner(account).SignForSimulation(rawTxn)
+	require.Nil(t, err)
+}
+
+func TestTransactionBuilderSigner_MultiEd25519(t *testing.T) {
+	accounts := []*aptosaccount.Account{newTestAccount(1), newTestAccount(2), newTestAccount(3)}
+	publicKey, err := NewMultiEd25519PublicKey([][]byte{accounts[0].PublicKey, accounts[1].PublicKey, accounts[2].PublicKey}, 2)
+	require.Nil(t, err)
+	_, err = NewMultiEd25519Signer(*publicKey, map[uint8]Signer{0: accounts[0]})
+	require.NotNil(t, err)
+	_, err = NewMultiEd25519Signer(*publicKey, map[uint8]Signer{0: accounts[0], 3: accounts[2]})
+	require.NotNil(t, err)
+
+	signer, err := NewMultiEd25519Signer(*publicKey, map[uint8]Signer{2: accounts[2], 0: accounts[0]})
+	require.Nil(t, err)
+	require.Equal(t, publicKey.AuthenticationKey(), signer.AuthenticationKey())
+	rawTxn := newTestRawTransaction(signer.AccountAddress())
+
+	data, err := NewTransactionBuilderSigner(signer).Sign(rawTxn)
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	authKey := signer.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+	auth, ok := signedTxn.Authenticator.(TransactionAuthenticatorMultiEd25519)
+	require.True(t, ok)
+	require.Equal(t, []byte{0xa0, 0, 0, 0}, auth.Signature.Bitmap)
+
+	_, err = NewTransactionBuilderSigner(signer).SignForSimulation(rawTxn)
+	require.Nil(t, err)
+}
+
+func TestTransactionBuilderSigner_Remote(t *testing.T) {
+	account := newTestAccount(1)
+	signer := NewRemoteSigner(ED25519_SCHEME, account.PublicKey, func(message []byte) ([]byte, error) {
+		return account.Sign(message, ""), nil
+	})
+	require.Equal(t, account.AuthenticationKey(), signer.AuthenticationKey())
+	address := *AccountAddressFromHex("0x1234")
+	signer.Address = &address
+	rawTxn := newTestRawTransaction(signer.AccountAddress())
+	require.Equal(t, address, rawTxn.Sender)
+
+	data, err := GenerateBCSTransaction(signer, rawTxn)
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	authKey := signer.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+
+	partial, err := NewMultiEd25519PartialSignatureWithSigner(rawTxn, 0, signer)
+	require.Nil(t, err)
+	require.True(t, ed25519.Verify(account.PublicKey, mustSigningMessage(t, rawTxn), partial.Signature.Signature))
+
+	signer.SigningFn = func(message []byte) ([]byte, error) {
+		return nil, errors.New("remote signer unavailable")
+	}
+	_, err = GenerateBCSTransaction(signer, rawTxn)
+	require.NotNil(t, err)
+}
+
+func mustSigningMessage(t *testing.T, signable Signable) []byte {
+	message, err := signable.GetSigningMessage()
+	require.Nil(t, err)
+	return message
+}
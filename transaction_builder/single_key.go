@@ -0,0 +1,150 @@
+package transactionbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/coming-chat/lcs"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	SECP256K1_PUBLICKEY_LENGTH = aptosaccount.Secp256k1PublicKeyLength
+	SECP256K1_SIGNATURE_LENGTH = aptosaccount.Secp256k1SignatureLength
+
+	SINGLE_KEY_SCHEME = aptosaccount.SingleKeyScheme
+)
+
+func init() {
+	lcs.RegisterEnum(
+		(*AnyPublicKey)(nil),
+
+		AnyPublicKeyEd25519{},
+		AnyPublicKeySecp256k1{},
+	)
+
+	lcs.RegisterEnum(
+		(*AnySignature)(nil),
+
+		AnySignatureEd25519{},
+		AnySignatureSecp256k1{},
+	)
+}
+
+// ------ Secp256k1 ------
+
+type Secp256k1PublicKey struct {
+	// The uncompressed public key with 0x04 prefix
+	PublicKey []byte `lcs:"publicKey"`
+}
+
+// NewSecp256k1PublicKey accepts the compressed or uncompressed public key, it's always stored uncompressed
+func NewSecp256k1PublicKey(publicKey []byte) (*Secp256k1PublicKey, error) {
+	key, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Secp256k1PublicKey: %v", err)
+	}
+	return &Secp256k1PublicKey{key.SerializeUncompressed()}, nil
+}
+
+type Secp256k1Signature struct {
+	// The `r || s` of ECDSA signature
+	Signature []byte `lcs:"signature"`
+}
+
+func NewSecp256k1Signature(signature []byte) (*Secp256k1Signature, error) {
+	if len(signature) != SECP256K1_SIGNATURE_LENGTH {
+		return nil, fmt.Errorf(`Secp256k1Signature length should be %d`, SECP256K1_SIGNATURE_LENGTH)
+	}
+	return &Secp256k1Signature{signature}, nil
+}
+
+// ------ AnyPublicKey ------
+
+// AnyPublicKey is the public key of `SingleKey` account
+type AnyPublicKey interface{}
+
+type AnyPublicKeyEd25519 struct {
+	PublicKey Ed25519PublicKey `lcs:"public_key"`
+}
+
+type AnyPublicKeySecp256k1 struct {
+	PublicKey Secp256k1PublicKey `lcs:"public_key"`
+}
+
+// NewAnyPublicKeyFromBytes decodes the BCS bytes of `AnyPublicKey`
+func NewAnyPublicKeyFromBytes(bytes []byte) (AnyPublicKey, error) {
+	var publicKey AnyPublicKey
+	if err := lcs.Unmarshal(bytes, &publicKey); err != nil {
+		return nil, err
+	}
+	return publicKey, nil
+}
+
+// SingleKeyAuthenticationKey returns sha3-256(bcs(AnyPublicKey) || SINGLE_KEY_SCHEME)
+func SingleKeyAuthenticationKey(publicKey AnyPublicKey) ([32]byte, error) {
+	bytes, err := lcs.Marshal(&publicKey)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha3.Sum256(append(bytes, SINGLE_KEY_SCHEME)), nil
+}
+
+// ------ AnySignature ------
+
+// AnySignature is the signature of `SingleKey` account
+type AnySignature interface{}
+
+type AnySignatureEd25519 struct {
+	Signature Ed25519Signature `lcs:"signature"`
+}
+
+type AnySignatureSecp256k1 struct {
+	Signature Secp256k1Signature `lcs:"signature"`
+}
+
+// NewAnySignatureFromBytes decodes the BCS bytes of `AnySignature`
+func NewAnySignatureFromBytes(bytes []byte) (AnySignature, error) {
+	var signature AnySignature
+	if err := lcs.Unmarshal(bytes, &signature); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// emptyAnySignature returns the invalid signature of the same variant of public key, for simulation
+func emptyAnySignature(publicKey AnyPublicKey) (AnySignature, error) {
+	switch publicKey.(type) {
+	case AnyPublicKeyEd25519:
+		return AnySignatureEd25519{Ed25519Signature{make([]byte, ED25519_SIGNATURE_LENGTH)}}, nil
+	case AnyPublicKeySecp256k1:
+		return AnySignatureSecp256k1{Secp256k1Signature{make([]byte, SECP256K1_SIGNATURE_LENGTH)}}, nil
+	}
+	return nil, fmt.Errorf("Unsupported public key %T.", publicKey)
+}
+
+func verifyAnySignature(publicKey AnyPublicKey, signature AnySignature, message []byte) error {
+	switch key := publicKey.(type) {
+	case AnyPublicKeyEd25519:
+		sig, ok := signature.(AnySignatureEd25519)
+		if !ok {
+			return fmt.Errorf("The signature %T doesn't match the ed25519 public key.", signature)
+		}
+		if !verifyEd25519(key.PublicKey, sig.Signature, message) {
+			return errors.New("Invalid ed25519 signature.")
+		}
+		return nil
+	case AnyPublicKeySecp256k1:
+		sig, ok := signature.(AnySignatureSecp256k1)
+		if !ok {
+			return fmt.Errorf("The signature %T doesn't match the secp256k1 public key.", signature)
+		}
+		if !aptosaccount.VerifySecp256k1(key.PublicKey.PublicKey, message, sig.Signature.Signature) {
+			return errors.New("Invalid secp256k1 signature.")
+		}
+		return nil
+	}
+	return fmt.Errorf("Unsupported public key %T.", publicKey)
+}
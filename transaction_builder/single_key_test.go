@@ -0,0 +1,79 @@
+package transactionbuilder
+
+import (
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptosaccount"
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleKeyAuthenticationKey(t *testing.T) {
+	account := newTestAccount(1)
+	publicKey, err := NewEd25519PublicKey(account.PublicKey)
+	require.Nil(t, err)
+	authKey, err := SingleKeyAuthenticationKey(AnyPublicKeyEd25519{*publicKey})
+	require.Nil(t, err)
+	require.NotEqual(t, account.AuthKey, authKey)
+
+	secp256k1Account, err := aptosaccount.GenerateSecp256k1Account()
+	require.Nil(t, err)
+	anyPublicKey, err := NewAnyPublicKeyFromBytes(secp256k1Account.PublicKeyBytes())
+	require.Nil(t, err)
+	require.Equal(t, AnyPublicKeySecp256k1{Secp256k1PublicKey{secp256k1Account.PublicKey}}, anyPublicKey)
+	authKey, err = SingleKeyAuthenticationKey(anyPublicKey)
+	require.Nil(t, err)
+	require.Equal(t, secp256k1Account.AuthKey, authKey)
+
+	compressed, err := NewSecp256k1PublicKey(secp256k1Account.PrivateKey.PubKey().SerializeCompressed())
+	require.Nil(t, err)
+	require.Equal(t, secp256k1Account.PublicKey, compressed.PublicKey)
+}
+
+func TestTransactionBuilderSigner_Secp256k1(t *testing.T) {
+	account, err := aptosaccount.GenerateSecp256k1Account()
+	require.Nil(t, err)
+	rawTxn := newTestRawTransaction(account.AccountAddress())
+
+	data, err := GenerateBCSTransaction(account, rawTxn)
+	require.Nil(t, err)
+	rawTxnBytes, err := lcs.Marshal(rawTxn)
+	require.Nil(t, err)
+	// SingleSender(4) → SingleKey(2) → Secp256k1Ecdsa(1) public key of 65 bytes
+	require.Equal(t, []byte{0x04, 0x02, 0x01, 0x41}, data[len(rawTxnBytes):len(rawTxnBytes)+4])
+
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	authKey := account.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+	require.NotNil(t, signedTxn.Verify(make([]byte, 32)))
+
+	auth := signedTxn.Authenticator.(TransactionAuthenticatorSingleSender).Sender.(AccountAuthenticatorSingleKey)
+	signature := auth.Signature.(AnySignatureSecp256k1).Signature.Signature
+	signature[0] ^= 0xff
+	require.NotNil(t, signedTxn.Verify(nil))
+
+	simulation, err := NewTransactionBuilderSigner(account).SignForSimulation(rawTxn)
+	require.Nil(t, err)
+	signedTxn, err = DecodeSignedTransaction(simulation)
+	require.Nil(t, err)
+	require.NotNil(t, signedTxn.Verify(nil))
+}
+
+func TestTransactionBuilderSigner_SingleKeyEd25519(t *testing.T) {
+	account := newTestAccount(1)
+	var anyPublicKey AnyPublicKey = AnyPublicKeyEd25519{Ed25519PublicKey{account.PublicKey}}
+	publicKey, err := lcs.Marshal(&anyPublicKey)
+	require.Nil(t, err)
+	signer := NewRemoteSigner(SINGLE_KEY_SCHEME, publicKey, func(message []byte) ([]byte, error) {
+		var signature AnySignature = AnySignatureEd25519{Ed25519Signature{account.Sign(message, "")}}
+		return lcs.Marshal(&signature)
+	})
+
+	data, err := GenerateBCSTransaction(signer, newTestRawTransaction(signer.AccountAddress()))
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	authKey := signer.AuthenticationKey()
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/coming-chat/go-aptos/aptosaccount"
 	"github.com/coming-chat/lcs"
 )
 
@@ -16,6 +15,13 @@ func init() {
 		TransactionPayloadScript{},
 		TransactionPayloadModuleBundle{}, // TODO: ModuleBundle will be removed.
 		TransactionPayloadEntryFunction{},
+		TransactionPayloadMultisig{},
+	)
+
+	lcs.RegisterEnum(
+		(*MultisigTransactionPayload)(nil),
+
+		TransactionPayloadEntryFunction{},
 	)
 
 	lcs.RegisterEnum(
@@ -27,12 +33,16 @@ func init() {
 		TransactionArgumentAddress{},
 		TransactionArgumentU8Vector{},
 		TransactionArgumentBool{},
+		TransactionArgumentU16{},
+		TransactionArgumentU32{},
+		TransactionArgumentU256{},
 	)
 
 	lcs.RegisterEnum(
 		(*RawTransactionWithData)(nil),
 
 		MultiAgentRawTransaction{},
+		FeePayerRawTransaction{},
 	)
 }
 
@@ -63,6 +73,16 @@ type TransactionPayloadEntryFunction struct {
 
 type TransactionPayloadModuleBundle struct{}
 
+// TransactionPayloadMultisig executes a transaction of on-chain multisig account (0x1::multisig_account)
+type TransactionPayloadMultisig struct {
+	MultisigAddress AccountAddress `lcs:"multisig_address"`
+	// It can be nil if the full payload has been stored on chain when the multisig transaction is created.
+	TransactionPayload MultisigTransactionPayload `lcs:"transaction_payload,optional"`
+}
+
+// MultisigTransactionPayload only has the variant `TransactionPayloadEntryFunction`
+type MultisigTransactionPayload interface{}
+
 type Module struct {
 	Code []byte `lcs:"code"`
 }
@@ -95,8 +115,11 @@ type TransactionArgumentU8 struct {
 type TransactionArgumentU64 struct {
 	Value uint64 `lcs:"value"`
 }
+
+// TransactionArgumentU128 stores the number in the named field, the embedded Uint128 would promote
+// its MarshalLCS to the variant and drop the enum tag of TransactionArgument.
 type TransactionArgumentU128 struct {
-	Uint128
+	Value Uint128 `lcs:"value"`
 }
 type TransactionArgumentAddress struct {
 	Value AccountAddress `lcs:"value"`
@@ -107,6 +130,17 @@ type TransactionArgumentU8Vector struct {
 type TransactionArgumentBool struct {
 	Value bool `lcs:"value"`
 }
+type TransactionArgumentU16 struct {
+	Value uint16 `lcs:"value"`
+}
+type TransactionArgumentU32 struct {
+	Value uint32 `lcs:"value"`
+}
+
+// TransactionArgumentU256 stores the number in the named field like TransactionArgumentU128
+type TransactionArgumentU256 struct {
+	Value Uint256 `lcs:"value"`
+}
 
 type RawTransactionWithData interface{}
 
@@ -115,16 +149,20 @@ type MultiAgentRawTransaction struct {
 	SecondarySignerAddresses []AccountAddress `lcs:"secondary_signer_addresses"`
 }
 
+type FeePayerRawTransaction struct {
+	RawTransaction           RawTransaction   `lcs:"raw_txn"`
+	SecondarySignerAddresses []AccountAddress `lcs:"secondary_signer_addresses"`
+	FeePayerAddress          AccountAddress   `lcs:"fee_payer_address"`
+}
+
 type SignedTransaction struct {
 	Transaction   *RawTransaction          `lcs:"transaction"`
 	Authenticator TransactionAuthenticator `lcs:"authenticator"`
 }
 
-func GenerateBCSTransaction(from *aptosaccount.Account, txn *RawTransaction) ([]byte, error) {
-	builder := NewTransactionBuilderEd25519(func(sm SigningMessage) []byte {
-		return from.Sign(sm, "")
-	}, from.PublicKey)
-	return builder.Sign(txn)
+// GenerateBCSTransaction signs the transaction by the signer, e.g. `*aptosaccount.Account`
+func GenerateBCSTransaction(from Signer, txn *RawTransaction) ([]byte, error) {
+	return NewTransactionBuilderSigner(from).Sign(txn)
 }
 
 func GenerateBCSSimulation(from ed25519.PublicKey, txn *RawTransaction) ([]byte, error) {
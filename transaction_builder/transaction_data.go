@@ -0,0 +1,298 @@
+package transactionbuilder
+
+import (
+	"fmt"
+
+	"github.com/coming-chat/lcs"
+)
+
+// The BCS layouts of the transactions returned by the rest api with `Accept: application/x-bcs`,
+// the transaction by hash or version is `TransactionData`, and the transaction list is `[]TransactionOnChainData`.
+
+func init() {
+	lcs.RegisterEnum(
+		(*TransactionData)(nil),
+
+		TransactionDataOnChain{},
+		TransactionDataPending{},
+	)
+
+	lcs.RegisterEnum(
+		(*Transaction)(nil),
+
+		TransactionUser{},
+		TransactionGenesis{},
+		TransactionBlockMetadata{},
+		TransactionStateCheckpoint{},
+		TransactionValidator{},
+		TransactionBlockMetadataExt{},
+		TransactionBlockEpilogue{},
+	)
+
+	lcs.RegisterEnum(
+		(*TransactionInfo)(nil),
+
+		TransactionInfoV0{},
+	)
+
+	lcs.RegisterEnum(
+		(*ExecutionStatus)(nil),
+
+		ExecutionStatusSuccess{},
+		ExecutionStatusOutOfGas{},
+		ExecutionStatusMoveAbort{},
+		ExecutionStatusExecutionFailure{},
+		ExecutionStatusMiscellaneousError{},
+	)
+
+	lcs.RegisterEnum(
+		(*AbortLocation)(nil),
+
+		AbortLocationModule{},
+		AbortLocationScript{},
+	)
+
+	lcs.RegisterEnum(
+		(*ContractEvent)(nil),
+
+		ContractEventV1{},
+		ContractEventV2{},
+	)
+
+	lcs.RegisterEnum(
+		(*WriteSet)(nil),
+
+		WriteSetV0{},
+	)
+
+	lcs.RegisterEnum(
+		(*StateKey)(nil),
+
+		StateKeyAccessPath{},
+		StateKeyTableItem{},
+		StateKeyRaw{},
+	)
+
+	lcs.RegisterEnum(
+		(*WriteOp)(nil),
+
+		WriteOpCreation{},
+		WriteOpModification{},
+		WriteOpDeletion{},
+		WriteOpCreationWithMetadata{},
+		WriteOpModificationWithMetadata{},
+		WriteOpDeletionWithMetadata{},
+	)
+
+	lcs.RegisterEnum(
+		(*StateValueMetadata)(nil),
+
+		StateValueMetadataV0{},
+		StateValueMetadataV1{},
+	)
+}
+
+// ------ TransactionData ------
+
+// TransactionData is the transaction by hash or version, it's pending if it has not been committed
+type TransactionData interface{}
+
+type TransactionDataOnChain TransactionOnChainData
+
+type TransactionDataPending SignedTransaction
+
+type TransactionOnChainData struct {
+	Version             uint64          `lcs:"version"`
+	Transaction         Transaction     `lcs:"transaction"`
+	Info                TransactionInfo `lcs:"info"`
+	Events              []ContractEvent `lcs:"events"`
+	AccumulatorRootHash [32]byte        `lcs:"accumulator_root_hash"`
+	Changes             WriteSet        `lcs:"changes"`
+}
+
+// ------ Transaction ------
+
+type Transaction interface{}
+
+// TransactionUser is the transaction submitted by users
+type TransactionUser SignedTransaction
+
+type TransactionBlockMetadata struct {
+	Id                       [32]byte       `lcs:"id"`
+	Epoch                    uint64         `lcs:"epoch"`
+	Round                    uint64         `lcs:"round"`
+	Proposer                 AccountAddress `lcs:"proposer"`
+	PreviousBlockVotesBitvec []byte         `lcs:"previous_block_votes_bitvec"`
+	FailedProposerIndices    []uint32       `lcs:"failed_proposer_indices"`
+	TimestampUsecs           uint64         `lcs:"timestamp_usecs"`
+}
+
+type TransactionStateCheckpoint struct {
+	Hash [32]byte `lcs:"hash"`
+}
+
+// The system transactions whose layouts are not supported, decoding them returns an error
+
+type TransactionGenesis struct{}
+type TransactionValidator struct{}
+type TransactionBlockMetadataExt struct{}
+type TransactionBlockEpilogue struct{}
+
+func (t *TransactionGenesis) UnmarshalLCS(d *lcs.Decoder) error {
+	return unsupportedTransactionError(t)
+}
+
+func (t *TransactionValidator) UnmarshalLCS(d *lcs.Decoder) error {
+	return unsupportedTransactionError(t)
+}
+
+func (t *TransactionBlockMetadataExt) UnmarshalLCS(d *lcs.Decoder) error {
+	return unsupportedTransactionError(t)
+}
+
+func (t *TransactionBlockEpilogue) UnmarshalLCS(d *lcs.Decoder) error {
+	return unsupportedTransactionError(t)
+}
+
+func unsupportedTransactionError(t Transaction) error {
+	return fmt.Errorf("Unsupported transaction %T.", t)
+}
+
+// ------ TransactionInfo ------
+
+type TransactionInfo interface{}
+
+type TransactionInfoV0 struct {
+	GasUsed             uint64          `lcs:"gas_used"`
+	Status              ExecutionStatus `lcs:"status"`
+	TransactionHash     [32]byte        `lcs:"transaction_hash"`
+	EventRootHash       [32]byte        `lcs:"event_root_hash"`
+	StateChangeHash     [32]byte        `lcs:"state_change_hash"`
+	StateCheckpointHash *[32]byte       `lcs:"state_checkpoint_hash,optional"`
+	AuxiliaryInfoHash   *[32]byte       `lcs:"auxiliary_info_hash,optional"`
+}
+
+type ExecutionStatus interface{}
+
+type ExecutionStatusSuccess struct{}
+
+type ExecutionStatusOutOfGas struct{}
+
+type ExecutionStatusMoveAbort struct {
+	Location AbortLocation `lcs:"location"`
+	Code     uint64        `lcs:"code"`
+	Info     *AbortInfo    `lcs:"info,optional"`
+}
+
+type ExecutionStatusExecutionFailure struct {
+	Location   AbortLocation `lcs:"location"`
+	Function   uint16        `lcs:"function"`
+	CodeOffset uint16        `lcs:"code_offset"`
+}
+
+type ExecutionStatusMiscellaneousError struct {
+	StatusCode *uint64 `lcs:"status_code,optional"`
+}
+
+type AbortLocation interface{}
+
+type AbortLocationModule ModuleId
+
+type AbortLocationScript struct{}
+
+type AbortInfo struct {
+	ReasonName  string `lcs:"reason_name"`
+	Description string `lcs:"description"`
+}
+
+// ------ ContractEvent ------
+
+type ContractEvent interface{}
+
+type EventKey struct {
+	CreationNumber uint64         `lcs:"creation_number"`
+	AccountAddress AccountAddress `lcs:"account_address"`
+}
+
+type ContractEventV1 struct {
+	Key            EventKey `lcs:"key"`
+	SequenceNumber uint64   `lcs:"sequence_number"`
+	TypeTag        TypeTag  `lcs:"type_tag"`
+	EventData      []byte   `lcs:"event_data"`
+}
+
+// ContractEventV2 is the module event
+type ContractEventV2 struct {
+	TypeTag   TypeTag `lcs:"type_tag"`
+	EventData []byte  `lcs:"event_data"`
+}
+
+// ------ WriteSet ------
+
+type WriteSet interface{}
+
+type WriteSetV0 struct {
+	// The BCS of Rust `BTreeMap<StateKey, WriteOp>`, the entries are sorted by the state key
+	WriteSet []WriteSetEntry `lcs:"write_set"`
+}
+
+type WriteSetEntry struct {
+	StateKey StateKey `lcs:"state_key"`
+	WriteOp  WriteOp  `lcs:"write_op"`
+}
+
+type StateKey interface{}
+
+// StateKeyAccessPath is the key of resource or module, the path is the BCS of `Path`
+type StateKeyAccessPath struct {
+	Address AccountAddress `lcs:"address"`
+	Path    []byte         `lcs:"path"`
+}
+
+type StateKeyTableItem struct {
+	Handle AccountAddress `lcs:"handle"`
+	Key    []byte         `lcs:"key"`
+}
+
+type StateKeyRaw struct {
+	Bytes []byte `lcs:"bytes"`
+}
+
+type WriteOp interface{}
+
+type WriteOpCreation struct {
+	Data []byte `lcs:"data"`
+}
+
+type WriteOpModification struct {
+	Data []byte `lcs:"data"`
+}
+
+type WriteOpDeletion struct{}
+
+type WriteOpCreationWithMetadata struct {
+	Data     []byte             `lcs:"data"`
+	Metadata StateValueMetadata `lcs:"metadata"`
+}
+
+type WriteOpModificationWithMetadata struct {
+	Data     []byte             `lcs:"data"`
+	Metadata StateValueMetadata `lcs:"metadata"`
+}
+
+type WriteOpDeletionWithMetadata struct {
+	Metadata StateValueMetadata `lcs:"metadata"`
+}
+
+type StateValueMetadata interface{}
+
+type StateValueMetadataV0 struct {
+	Deposit           uint64 `lcs:"deposit"`
+	CreationTimeUsecs uint64 `lcs:"creation_time_usecs"`
+}
+
+type StateValueMetadataV1 struct {
+	SlotDeposit       uint64 `lcs:"slot_deposit"`
+	BytesDeposit      uint64 `lcs:"bytes_deposit"`
+	CreationTimeUsecs uint64 `lcs:"creation_time_usecs"`
+}
@@ -25,6 +25,21 @@ func TestTransactionArgumentSerilize(t *testing.T) {
 			val:  TransactionArgumentU128{Uint128{big.NewInt(1311768467750121216)}},
 			want: []byte{0x00, 0xEF, 0xCD, 0xAB, 0x78, 0x56, 0x34, 0x12, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
 		},
+		{
+			name: "U256",
+			val:  TransactionArgumentU256{Uint256{big.NewInt(1311768467750121216)}},
+			want: append([]byte{0x00, 0xEF, 0xCD, 0xAB, 0x78, 0x56, 0x34, 0x12}, make([]byte, 24)...),
+		},
+		{
+			name: "U256 enum",
+			val:  []TransactionArgument{TransactionArgumentU256{Uint256{big.NewInt(1)}}},
+			want: append([]byte{0x01, 0x08, 0x01}, make([]byte, 31)...),
+		},
+		{
+			name: "U16 enum",
+			val:  []TransactionArgument{TransactionArgumentU16{0x1234}},
+			want: []byte{0x01, 0x06, 0x34, 0x12},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -85,7 +100,7 @@ func TestTransactionArgumentU128(t *testing.T) {
 					t.Errorf("TransactionArgumentU128 unmarshal error = %v", err)
 					return
 				}
-				if u.Cmp(u2.Int) != 0 {
+				if u.Value.Cmp(u2.Value.Int) != 0 {
 					t.Errorf("TransactionArgumentU128 restore big int failed, %v -> %v", u, u2)
 				}
 			}
@@ -20,6 +20,9 @@ func init() {
 		TypeTagSigner{},
 		TypeTagVector{},
 		TypeTagStruct{},
+		TypeTagU16{},
+		TypeTagU32{},
+		TypeTagU256{},
 	)
 }
 
@@ -29,6 +32,9 @@ type TypeTagBool struct{}
 type TypeTagU8 struct{}
 type TypeTagU64 struct{}
 type TypeTagU128 struct{}
+type TypeTagU16 struct{}
+type TypeTagU32 struct{}
+type TypeTagU256 struct{}
 type TypeTagAddress struct{}
 type TypeTagSigner struct{}
 type TypeTagVector struct {
@@ -41,26 +47,55 @@ type TypeTagStruct struct {
 	TypeArgs   []TypeTag      `lcs:"type_args"`
 }
 
+// NewTypeTagStructFromString parses a struct tag such as `0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>`,
+// the type arguments are parsed recursively.
 func NewTypeTagStructFromString(tag string) (*TypeTagStruct, error) {
-	if strings.Contains(tag, "<") {
-		return nil, errors.New("Not implemented")
-	}
-
-	parts := strings.Split(tag, "::")
-	if len(parts) != 3 {
-		return nil, errors.New("Invalid struct tag string literal.")
+	parser, err := NewTypeTagParser(tag)
+	if err != nil {
+		return nil, err
 	}
-	addr, err := NewAccountAddressFromHex(parts[0])
+	typeTag, err := parser.ParseTypeTag()
 	if err != nil {
 		return nil, err
 	}
-	return &TypeTagStruct{
-		Address:    *addr,
-		ModuleName: Identifier(parts[1]),
-		Name:       Identifier(parts[2]),
-	}, nil
+	if len(parser.Tokens) != 0 {
+		return nil, errors.New("Invalid struct tag string literal.")
+	}
+	structTag, ok := typeTag.(TypeTagStruct)
+	if !ok {
+		return nil, errors.New("Invalid struct tag string literal.")
+	}
+	return &structTag, nil
 }
 
 func (t *TypeTagStruct) ShortFunctionName() string {
 	return fmt.Sprintf("%v::%v::%v", t.Address.ToShortString(), t.ModuleName, t.Name)
 }
+
+// The canonical strings of type tags, they can be parsed back by `TypeTagParser`.
+
+func (TypeTagBool) String() string    { return "bool" }
+func (TypeTagU8) String() string      { return "u8" }
+func (TypeTagU16) String() string     { return "u16" }
+func (TypeTagU32) String() string     { return "u32" }
+func (TypeTagU64) String() string     { return "u64" }
+func (TypeTagU128) String() string    { return "u128" }
+func (TypeTagU256) String() string    { return "u256" }
+func (TypeTagAddress) String() string { return "address" }
+func (TypeTagSigner) String() string  { return "signer" }
+
+func (t TypeTagVector) String() string {
+	return fmt.Sprintf("vector<%v>", t.Value)
+}
+
+func (t TypeTagStruct) String() string {
+	name := t.ShortFunctionName()
+	if len(t.TypeArgs) == 0 {
+		return name
+	}
+	args := make([]string, len(t.TypeArgs))
+	for i, arg := range t.TypeArgs {
+		args[i] = fmt.Sprintf("%v", arg)
+	}
+	return name + "<" + strings.Join(args, ", ") + ">"
+}
@@ -0,0 +1,69 @@
+package transactionbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/coming-chat/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTypeTagStructFromString(t *testing.T) {
+	tag, err := NewTypeTagStructFromString("0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>")
+	require.Nil(t, err)
+	require.Equal(t, "0x1::coin::CoinStore", tag.ShortFunctionName())
+	require.Equal(t, []TypeTag{TypeTagStruct{
+		Address:    *AccountAddressFromHex("0x1"),
+		ModuleName: "aptos_coin",
+		Name:       "AptosCoin",
+		TypeArgs:   []TypeTag{},
+	}}, tag.TypeArgs)
+
+	_, err = NewTypeTagStructFromString("u64")
+	require.NotNil(t, err)
+	_, err = NewTypeTagStructFromString("0x1::coin::CoinStore<u8> u8")
+	require.NotNil(t, err)
+	_, err = NewTypeTagStructFromString("0x1::coin")
+	require.NotNil(t, err)
+}
+
+func TestTypeTagString(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{tag: "bool", want: "bool"},
+		{tag: "u8", want: "u8"},
+		{tag: "u16", want: "u16"},
+		{tag: "u32", want: "u32"},
+		{tag: "u64", want: "u64"},
+		{tag: "u128", want: "u128"},
+		{tag: "u256", want: "u256"},
+		{tag: "address", want: "address"},
+		{tag: "signer", want: "signer"},
+		{tag: "vector< vector<u8> >", want: "vector<vector<u8>>"},
+		{tag: "0x0::m::S", want: "0x0::m::S"},
+		{tag: "0x01::coin::CoinStore<0x1::aptos_coin::AptosCoin>", want: "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>"},
+		{
+			tag:  "0x1::pool::LP<0xA1::coin::X<u8,>,vector<0x1::string::String>>",
+			want: "0x1::pool::LP<0xa1::coin::X<u8>, vector<0x1::string::String>>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			parser, err := NewTypeTagParser(tt.tag)
+			require.Nil(t, err)
+			tag, err := parser.ParseTypeTag()
+			require.Nil(t, err)
+			require.Equal(t, tt.want, fmt.Sprint(tag))
+
+			// string -> TypeTag -> BCS -> TypeTag -> string
+			data, err := lcs.Marshal(&tag)
+			require.Nil(t, err)
+			var decoded TypeTag
+			err = lcs.Unmarshal(data, &decoded)
+			require.Nil(t, err)
+			require.Equal(t, tt.want, fmt.Sprint(decoded))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+)
+
+/**
+ * Verify recomputes the signing message of the raw transaction and verifies all the signatures of the authenticator,
+ * including the secondary signers of multi-agent transaction and the fee payer.
+ * @param senderAuthKey The on-chain authentication key of sender, if it's not nil, the authentication key of sender's
+ * public key must match it.
+ */
+func (t *SignedTransaction) Verify(senderAuthKey []byte) error {
+	if t.Transaction == nil {
+		return errors.New("Invalid signed transaction.")
+	}
+	var senderAuthenticator AccountAuthenticator
+	switch auth := t.Authenticator.(type) {
+	case TransactionAuthenticatorEd25519:
+		senderAuthenticator = AccountAuthenticatorEd25519(auth)
+		if err := verifyAccountAuthenticator(senderAuthenticator, t.Transaction); err != nil {
+			return err
+		}
+	case TransactionAuthenticatorMultiEd25519:
+		senderAuthenticator = AccountAuthenticatorMultiEd25519(auth)
+		if err := verifyAccountAuthenticator(senderAuthenticator, t.Transaction); err != nil {
+			return err
+		}
+	case TransactionAuthenticatorSingleSender:
+		senderAuthenticator = auth.Sender
+		if err := verifyAccountAuthenticator(senderAuthenticator, t.Transaction); err != nil {
+			return err
+		}
+	case TransactionAuthenticatorMultiAgent:
+		senderAuthenticator = auth.Sender
+		rawTxn := &MultiAgentRawTransaction{
+			RawTransaction:           *t.Transaction,
+			SecondarySignerAddresses: auth.SecondarySignerAddresses,
+		}
+		if err := verifyMultiSigners(rawTxn, auth.Sender, auth.SecondarySignerAddresses, auth.SecondarySigners); err != nil {
+			return err
+		}
+	case TransactionAuthenticatorFeePayer:
+		senderAuthenticator = auth.Sender
+		rawTxn := &FeePayerRawTransaction{
+			RawTransaction:           *t.Transaction,
+			SecondarySignerAddresses: auth.SecondarySignerAddresses,
+			FeePayerAddress:          auth.FeePayerAddress,
+		}
+		if err := verifyMultiSigners(rawTxn, auth.Sender, auth.SecondarySignerAddresses, auth.SecondarySigners); err != nil {
+			return err
+		}
+		if err := verifyAccountAuthenticator(auth.FeePayerSigner, rawTxn); err != nil {
+			return fmt.Errorf("Invalid signature of fee payer: %v", err)
+		}
+	default:
+		return fmt.Errorf("Unsupported transaction authenticator %T.", t.Authenticator)
+	}
+
+	if senderAuthKey != nil {
+		authKey, err := accountAuthenticatorAddress(senderAuthenticator)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(authKey[:], senderAuthKey) {
+			return errors.New("The public key of sender doesn't match the authentication key.")
+		}
+	}
+	return nil
+}
+
+func verifyMultiSigners(signable Signable, sender AccountAuthenticator, secondaryAddresses []AccountAddress, secondarySigners []AccountAuthenticator) error {
+	if len(secondaryAddresses) != len(secondarySigners) {
+		return errors.New("The number of secondary signer addresses and signers are not the same.")
+	}
+	if err := verifyAccountAuthenticator(sender, signable); err != nil {
+		return err
+	}
+	for i, signer := range secondarySigners {
+		if err := verifyAccountAuthenticator(signer, signable); err != nil {
+			return fmt.Errorf("Invalid signature of secondary signer %v: %v", secondaryAddresses[i].ToShortString(), err)
+		}
+	}
+	return nil
+}
+
+func verifyAccountAuthenticator(authenticator AccountAuthenticator, signable Signable) error {
+	signingMessage, err := signable.GetSigningMessage()
+	if err != nil {
+		return err
+	}
+	switch auth := authenticator.(type) {
+	case AccountAuthenticatorEd25519:
+		if !verifyEd25519(auth.PublicKey, auth.Signature, signingMessage) {
+			return errors.New("Invalid ed25519 signature.")
+		}
+		return nil
+	case AccountAuthenticatorMultiEd25519:
+		return verifyMultiEd25519(auth.PublicKey, auth.Signature, signingMessage)
+	case AccountAuthenticatorSingleKey:
+		return verifyAnySignature(auth.PublicKey, auth.Signature, signingMessage)
+	case AccountAuthenticatorMultiKey:
+		return verifyMultiKey(auth.PublicKey, auth.Signature, signingMessage)
+	}
+	return fmt.Errorf("Unsupported account authenticator %T.", authenticator)
+}
+
+func verifyEd25519(publicKey Ed25519PublicKey, signature Ed25519Signature, message []byte) bool {
+	if len(publicKey.PublicKey) != ed25519.PublicKeySize || len(signature.Signature) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(publicKey.PublicKey, message, signature.Signature)
+}
+
+// verifyMultiEd25519 checks the bitmap and the threshold, the i-th signature is verified by the key of the i-th set bit
+func verifyMultiEd25519(publicKey MultiEd25519PublicKey, signature MultiEd25519Signature, message []byte) error {
+	if len(signature.Bitmap) != MULTI_ED25519_SIGNATURE_BITMAP_LENGTH {
+		return errors.New("Invalid multi ed25519 signature bitmap.")
+	}
+	bits := []int{}
+	for i := 0; i < MAX_SIGNATURES_SUPPORTED; i++ {
+		if signature.Bitmap[i/8]&(0b10000000>>(i%8)) != 0 {
+			bits = append(bits, i)
+		}
+	}
+	if len(bits) != len(signature.Signatures) {
+		return fmt.Errorf("The bitmap has %v bits set, but there are %v signatures.", len(bits), len(signature.Signatures))
+	}
+	if len(bits) < int(publicKey.Threshold) {
+		return fmt.Errorf("Not enough signatures: %v of %v.", len(bits), publicKey.Threshold)
+	}
+	for i, bit := range bits {
+		if bit >= len(publicKey.PublicKeys) {
+			return fmt.Errorf("Invalid bit %v of bitmap.", bit)
+		}
+		if !verifyEd25519(publicKey.PublicKeys[bit], signature.Signatures[i], message) {
+			return fmt.Errorf("Invalid signature of key %v.", bit)
+		}
+	}
+	return nil
+}
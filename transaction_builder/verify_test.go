@@ -0,0 +1,123 @@
+package transactionbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRawTransaction(sender AccountAddress) *RawTransaction {
+	return &RawTransaction{
+		Sender:         sender,
+		SequenceNumber: 1,
+		Payload: TransactionPayloadEntryFunction{
+			ModuleName:   ModuleId{Address: *AccountAddressFromHex("0x1"), Name: "aptos_account"},
+			FunctionName: "transfer",
+			TyArgs:       []TypeTag{},
+			Args:         [][]byte{make([]byte, 32), BCSSerializeBasicValue(uint64(100))},
+		},
+		MaxGasAmount:            2000,
+		GasUnitPrice:            100,
+		ExpirationTimestampSecs: 1660000000,
+		ChainId:                 2,
+	}
+}
+
+func TestSignedTransaction_Verify_Ed25519(t *testing.T) {
+	signer := newTestEd25519Builder(1)
+	authKey := newEd25519PublicKey(t, signer.PublicKey).AuthenticationKey()
+	rawTxn := newTestRawTransaction(authKey)
+	data, err := signer.Sign(rawTxn)
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+
+	require.Nil(t, signedTxn.Verify(nil))
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+	require.NotNil(t, signedTxn.Verify(make([]byte, 32)))
+
+	signedTxn.Transaction.SequenceNumber++
+	require.NotNil(t, signedTxn.Verify(nil))
+}
+
+func TestSignedTransaction_Verify_MultiEd25519(t *testing.T) {
+	signers := []*TransactionBuilderEd25519{newTestEd25519Builder(1), newTestEd25519Builder(2), newTestEd25519Builder(3)}
+	publicKey, err := NewMultiEd25519PublicKey([][]byte{signers[0].PublicKey, signers[1].PublicKey, signers[2].PublicKey}, 2)
+	require.Nil(t, err)
+	authKey := publicKey.AuthenticationKey()
+	rawTxn := newTestRawTransaction(authKey)
+	aggregator, err := NewMultiEd25519SignatureAggregator(*publicKey, rawTxn)
+	require.Nil(t, err)
+	for _, i := range []uint8{1, 2} {
+		partial, err := NewMultiEd25519PartialSignature(rawTxn, i, signers[i].SigningFn)
+		require.Nil(t, err)
+		require.Nil(t, aggregator.Add(partial))
+	}
+	data, err := aggregator.Build()
+	require.Nil(t, err)
+	signedTxn, err := DecodeSignedTransaction(data)
+	require.Nil(t, err)
+	require.Nil(t, signedTxn.Verify(authKey[:]))
+
+	authenticator := signedTxn.Authenticator.(TransactionAuthenticatorMultiEd25519)
+	// the bitmap doesn't match the signers
+	authenticator.Signature.Bitmap = []byte{0b11000000, 0, 0, 0}
+	signedTxn.Authenticator = authenticator
+	require.NotNil(t, signedTxn.Verify(nil))
+	// the bitmap doesn't match the number of signatures
+	authenticator.Signature.Bitmap = []byte{0b01110000, 0, 0, 0}
+	signedTxn.Authenticator = authenticator
+	require.NotNil(t, signedTxn.Verify(nil))
+	// below the threshold
+	authenticator.Signature.Bitmap = []byte{0b00100000, 0, 0, 0}
+	authenticator.Signature.Signatures = authenticator.Signature.Signatures[1:]
+	signedTxn.Authenticator = authenticator
+	require.NotNil(t, signedTxn.Verify(nil))
+}
+
+func TestSignedTransaction_Verify_MultiAgentAndFeePayer(t *testing.T) {
+	sender, secondary, feePayer := newTestEd25519Builder(1), newTestEd25519Builder(2), newTestEd25519Builder(3)
+	senderAuthKey := newEd25519PublicKey(t, sender.PublicKey).AuthenticationKey()
+	secondaryAddress := AccountAddress(newEd25519PublicKey(t, secondary.PublicKey).AuthenticationKey())
+	rawTxn := newTestRawTransaction(senderAuthKey)
+
+	multiAgent := NewMultiAgentTransactionBuilder(rawTxn, []AccountAddress{secondaryAddress})
+	senderAuth, err := sender.SignAccountAuthenticator(&multiAgent.RawTxn)
+	require.Nil(t, err)
+	secondaryAuth, err := secondary.SignAccountAuthenticator(&multiAgent.RawTxn)
+	require.Nil(t, err)
+	multiAgent.AddSenderAuthenticator(senderAuth)
+	require.Nil(t, multiAgent.AddSecondarySignerAuthenticator(secondaryAuth))
+	signedTxn, err := multiAgent.Build()
+	require.Nil(t, err)
+	require.Nil(t, signedTxn.Verify(senderAuthKey[:]))
+
+	// the secondary signer signed another transaction
+	otherTxn := *multiAgent
+	otherTxn.RawTxn.RawTransaction.SequenceNumber++
+	otherAuth, err := secondary.SignAccountAuthenticator(&otherTxn.RawTxn)
+	require.Nil(t, err)
+	require.Nil(t, multiAgent.AddSecondarySignerAuthenticator(otherAuth))
+	signedTxn, err = multiAgent.Build()
+	require.Nil(t, err)
+	require.NotNil(t, signedTxn.Verify(nil))
+
+	feePayerBuilder := NewFeePayerTransactionBuilder(rawTxn, nil, *AccountAddressFromHex("0x33"))
+	senderAuth, err = sender.SignAccountAuthenticator(&feePayerBuilder.RawTxn)
+	require.Nil(t, err)
+	feePayerAuth, err := feePayer.SignAccountAuthenticator(&feePayerBuilder.RawTxn)
+	require.Nil(t, err)
+	feePayerBuilder.AddSenderAuthenticator(senderAuth)
+	feePayerBuilder.AddFeePayerAuthenticator(feePayerAuth)
+	signedTxn, err = feePayerBuilder.Build()
+	require.Nil(t, err)
+	require.Nil(t, signedTxn.Verify(senderAuthKey[:]))
+
+	// the sender signed the multi-agent transaction instead of the fee payer transaction
+	multiAgentSenderAuth, err := sender.SignAccountAuthenticator(&multiAgent.RawTxn)
+	require.Nil(t, err)
+	feePayerBuilder.AddSenderAuthenticator(multiAgentSenderAuth)
+	signedTxn, err = feePayerBuilder.Build()
+	require.Nil(t, err)
+	require.NotNil(t, signedTxn.Verify(nil))
+}
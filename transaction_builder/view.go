@@ -0,0 +1,292 @@
+package transactionbuilder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+	"github.com/coming-chat/lcs"
+)
+
+// ViewFunctionCaller is implemented by `aptosclient.RestClient`
+type ViewFunctionCaller interface {
+	View(out interface{}, function string, typeArgs []string, args []any, ledgerVersion string) error
+}
+
+type RemoteViewFunctionCaller interface {
+	RemoteModuleFetcher
+	ViewFunctionCaller
+}
+
+/**
+ * Fetches the ABI of the module and executes the view function.
+ * @param function The function id, e.g. `0x1::coin::balance`
+ * @param ledgerVersion Ledger version to execute the function, If not provided, it will be the latest version
+ * @returns The return values decoded according to the function's ABI, see `DecodeViewResult`
+ */
+func CallViewFunction(client RemoteViewFunctionCaller, function string, tyTags []string, args []any, ledgerVersion string) ([]any, error) {
+	builder, err := NewTransactionBuilderRemoteABIWithFunc(function, client)
+	if err != nil {
+		return nil, err
+	}
+	return builder.View(client, function, tyTags, args, ledgerVersion)
+}
+
+func (tb *TransactionBuilderRemoteABI) findViewFunction(function string) (string, *aptostypes.MoveFunction, error) {
+	tag, err := NewTypeTagStructFromString(function)
+	if err != nil {
+		return "", nil, fmt.Errorf("Invalid function: %v", function)
+	}
+	function = fmt.Sprintf("%v::%v::%v", tag.Address.ToShortString(), tag.ModuleName, tag.Name)
+	funcABI, ok := tb.ViewFunctions[function]
+	if !ok {
+		return "", nil, fmt.Errorf("Cannot find view function: %v", function)
+	}
+	return function, &funcABI, nil
+}
+
+func (tb *TransactionBuilderRemoteABI) View(caller ViewFunctionCaller, function string, tyTags []string, args []any, ledgerVersion string) ([]any, error) {
+	function, _, err := tb.findViewFunction(function)
+	if err != nil {
+		return nil, err
+	}
+	viewArgs, err := tb.BuildViewArguments(function, tyTags, args)
+	if err != nil {
+		return nil, err
+	}
+	values := []json.RawMessage{}
+	err = caller.View(&values, function, tyTags, viewArgs, ledgerVersion)
+	if err != nil {
+		return nil, err
+	}
+	return tb.DecodeViewResult(function, tyTags, values)
+}
+
+// BuildViewArguments validates args against the params of the view function, and encodes them to the JSON values accepted by the `/view` api.
+func (tb *TransactionBuilderRemoteABI) BuildViewArguments(function string, tyTags []string, args []any) ([]any, error) {
+	_, funcABI, err := tb.findViewFunction(function)
+	if err != nil {
+		return nil, err
+	}
+	if len(tyTags) != len(funcABI.GenericTypeParams) {
+		return nil, errors.New("Wrong number of type args provided.")
+	}
+
+	params := []string{}
+	for _, param := range funcABI.Params {
+		if param == "signer" || param == "&signer" {
+			continue
+		}
+		params = append(params, param)
+	}
+	if len(params) != len(args) {
+		return nil, errors.New("Wrong number of args provided.")
+	}
+
+	res := []any{}
+	for i, param := range params {
+		parser, err := NewTypeTagParserWithTypeArgs(param, tyTags)
+		if err != nil {
+			return nil, err
+		}
+		typeTag, err := parser.ParseTypeTag()
+		if err != nil {
+			return nil, err
+		}
+
+		// Serialize and then deserialize the arg, so that it accepts the same inputs as the transaction builder.
+		var b bytes.Buffer
+		err = serializeArg(args[i], typeTag, lcs.NewEncoder(&b))
+		if err != nil {
+			return nil, err
+		}
+		value, err := deserializeArg(lcs.NewDecoder(&b), typeTag)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, toViewArgument(value))
+	}
+	return res, nil
+}
+
+func toViewArgument(value any) any {
+	switch v := value.(type) {
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case *big.Int:
+		return v.String()
+	case *big.Rat:
+		// The fixed point numbers are exact in decimal with the digits of fractional bits
+		return strings.TrimRight(strings.TrimRight(v.FloatString(64), "0"), ".")
+	case AccountAddress:
+		return v.ToString()
+	case []byte:
+		return "0x" + hex.EncodeToString(v)
+	case []any:
+		res := make([]any, len(v))
+		for i, item := range v {
+			res[i] = toViewArgument(item)
+		}
+		return res
+	}
+	return value
+}
+
+/**
+ * Decodes the return values of the view function according to its declared return types.
+ * bool, u8, u16, u32 -> bool, uint8, uint16, uint32
+ * u64 -> uint64
+ * u128, u256 -> *big.Int
+ * address -> AccountAddress
+ * vector<u8> -> []byte
+ * vector<T> -> []any
+ * 0x1::string::String -> string
+ * 0x1::option::Option<T> -> nil or the value of T
+ * 0x1::object::Object<T> -> AccountAddress
+ * 0x1::fixed_point32::FixedPoint32, 0x1::fixed_point64::FixedPoint64 -> *big.Rat
+ * Other structs keep the form of json.Unmarshal into `any`.
+ */
+func (tb *TransactionBuilderRemoteABI) DecodeViewResult(function string, tyTags []string, values []json.RawMessage) ([]any, error) {
+	_, funcABI, err := tb.findViewFunction(function)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != len(funcABI.Return) {
+		return nil, fmt.Errorf("The function should return %v values, but got %v.", len(funcABI.Return), len(values))
+	}
+	res := []any{}
+	for i, returnType := range funcABI.Return {
+		parser, err := NewTypeTagParserWithTypeArgs(returnType, tyTags)
+		if err != nil {
+			return nil, err
+		}
+		typeTag, err := parser.ParseTypeTag()
+		if err != nil {
+			return nil, err
+		}
+		value, err := decodeViewValue(values[i], typeTag)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, value)
+	}
+	return res, nil
+}
+
+func decodeViewValue(raw json.RawMessage, typeTag TypeTag) (any, error) {
+	switch tag := typeTag.(type) {
+	case TypeTagBool:
+		var v bool
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case TypeTagU8:
+		var v uint8
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case TypeTagU16:
+		var v uint16
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case TypeTagU32:
+		var v uint32
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case TypeTagU64:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		return strconv.ParseUint(s, 10, 64)
+	case TypeTagU128, TypeTagU256:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		v, ok := big.NewInt(0).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("Invalid integer value %v.", s)
+		}
+		return v, nil
+	case TypeTagAddress:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		addr, err := NewAccountAddressFromHex(s)
+		if err != nil {
+			return nil, err
+		}
+		return *addr, nil
+	case TypeTagVector:
+		if _, ok := tag.Value.(TypeTagU8); ok {
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, err
+			}
+			return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		}
+		items := []json.RawMessage{}
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		res := []any{}
+		for _, item := range items {
+			value, err := decodeViewValue(item, tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, value)
+		}
+		return res, nil
+	case TypeTagStruct:
+		switch tag.ShortFunctionName() {
+		case "0x1::string::String":
+			var v string
+			err := json.Unmarshal(raw, &v)
+			return v, err
+		case "0x1::option::Option":
+			var v struct {
+				Vec []json.RawMessage `json:"vec"`
+			}
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return nil, err
+			}
+			if len(v.Vec) == 0 || len(tag.TypeArgs) != 1 {
+				return nil, nil
+			}
+			return decodeViewValue(v.Vec[0], tag.TypeArgs[0])
+		case "0x1::object::Object":
+			var v struct {
+				Inner json.RawMessage `json:"inner"`
+			}
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return nil, err
+			}
+			return decodeViewValue(v.Inner, TypeTagAddress{})
+		case "0x1::fixed_point32::FixedPoint32", "0x1::fixed_point64::FixedPoint64":
+			var v struct {
+				Value string `json:"value"`
+			}
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return nil, err
+			}
+			value, ok := big.NewInt(0).SetString(v.Value, 10)
+			if !ok {
+				return nil, fmt.Errorf("Invalid fixed point value %v.", v.Value)
+			}
+			if tag.Name == "FixedPoint32" {
+				return fixedPointFromRawValue(value, 32), nil
+			}
+			return fixedPointFromRawValue(value, 64), nil
+		}
+	}
+	var v any
+	err := json.Unmarshal(raw, &v)
+	return v, err
+}
@@ -0,0 +1,94 @@
+package transactionbuilder
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/coming-chat/go-aptos/aptostypes"
+	"github.com/stretchr/testify/require"
+)
+
+type mockViewClient struct {
+	module   *aptostypes.MoveModule
+	response string
+
+	function string
+	typeArgs []string
+	args     []any
+}
+
+func (c *mockViewClient) GetAccountModule(address, moduleName string, version uint64) (*aptostypes.MoveModule, error) {
+	return c.module, nil
+}
+
+func (c *mockViewClient) View(out interface{}, function string, typeArgs []string, args []any, ledgerVersion string) error {
+	c.function, c.typeArgs, c.args = function, typeArgs, args
+	return json.Unmarshal([]byte(c.response), out)
+}
+
+func TestCallViewFunction(t *testing.T) {
+	client := &mockViewClient{
+		module: &aptostypes.MoveModule{
+			Abi: &aptostypes.MoveModuleAbi{
+				Address: "0x1",
+				Name:    "pool",
+				ExposedFunctions: []aptostypes.MoveFunction{
+					{
+						Name:              "quote",
+						Visibility:        "public",
+						IsView:            true,
+						GenericTypeParams: []interface{}{map[string]any{"constraints": []any{}}},
+						Params:            []string{"address", "u64", "vector<u8>", "vector<u128>", "0x1::string::String"},
+						Return:            []string{"u64", "u128", "vector<address>", "0x1::coin::Coin<T0>", "0x1::string::String"},
+					},
+				},
+			},
+		},
+		response: `["12", "340282366920938463463374607431768211455", ["0x1", "0x2"], {"value": "5"}, "hello"]`,
+	}
+
+	values, err := CallViewFunction(client, "0x1::pool::quote", []string{"0x1::aptos_coin::AptosCoin"}, []any{
+		"0x1", 100, []byte{1, 2}, []string{"1", "2"}, "name",
+	}, "")
+	require.Nil(t, err)
+	require.Equal(t, "0x1::pool::quote", client.function)
+	require.Equal(t, []any{
+		"0x0000000000000000000000000000000000000000000000000000000000000001",
+		"100",
+		"0x0102",
+		[]any{"1", "2"},
+		"name",
+	}, client.args)
+
+	maxU128 := big.NewInt(0).Sub(big.NewInt(0).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	require.Equal(t, uint64(12), values[0])
+	require.Equal(t, 0, maxU128.Cmp(values[1].(*big.Int)))
+	require.Equal(t, []any{*AccountAddressFromHex("0x1"), *AccountAddressFromHex("0x2")}, values[2])
+	require.Equal(t, map[string]any{"value": "5"}, values[3])
+	require.Equal(t, "hello", values[4])
+
+	_, err = CallViewFunction(client, "0x1::pool::quote", []string{}, []any{"0x1", 100, []byte{}, []string{}, ""}, "")
+	require.NotNil(t, err)
+	_, err = CallViewFunction(client, "0x1::pool::quote", []string{"u8"}, []any{"0x1", -1, []byte{}, []string{}, ""}, "")
+	require.NotNil(t, err)
+}
+
+func Test_decodeViewValue_Struct(t *testing.T) {
+	value, err := decodeViewValue(json.RawMessage(`{"vec":["12"]}`), optionTag(TypeTagU64{}))
+	require.Nil(t, err)
+	require.Equal(t, uint64(12), value)
+	value, err = decodeViewValue(json.RawMessage(`{"vec":[]}`), optionTag(TypeTagU64{}))
+	require.Nil(t, err)
+	require.Nil(t, value)
+
+	objectTag := TypeTagStruct{*AccountAddressFromHex("0x1"), "object", "Object", []TypeTag{}}
+	value, err = decodeViewValue(json.RawMessage(`{"inner":"0x222"}`), objectTag)
+	require.Nil(t, err)
+	require.Equal(t, *AccountAddressFromHex("0x222"), value)
+
+	value, err = decodeViewValue(json.RawMessage(`{"value":"6442450944"}`), fixedPointTag(32))
+	require.Nil(t, err)
+	require.Equal(t, big.NewRat(3, 2), value)
+	require.Equal(t, "1.5", toViewArgument(value))
+}